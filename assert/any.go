@@ -9,16 +9,20 @@ import (
 
 // AssertableAny is the assertable structure for interface{} values.
 type AssertableAny struct {
-	t      *testing.T
+	assertion
 	actual values.AnyValue
 }
 
 // That returns an AssertableAny structure initialized with the test reference and the actual value to assert.
 func That(t *testing.T, actual interface{}) AssertableAny {
 	t.Helper()
+	return that(assertion{t: t}, actual)
+}
+
+func that(a assertion, actual interface{}) AssertableAny {
 	return AssertableAny{
-		t:      t,
-		actual: values.NewAnyValue(actual),
+		assertion: a,
+		actual:    values.NewAnyValue(actual),
 	}
 }
 
@@ -26,7 +30,7 @@ func That(t *testing.T, actual interface{}) AssertableAny {
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableAny) IsEqualTo(expected interface{}) AssertableAny {
 	if !a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -35,7 +39,7 @@ func (a AssertableAny) IsEqualTo(expected interface{}) AssertableAny {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableAny) IsNotEqualTo(expected interface{}) AssertableAny {
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -43,7 +47,7 @@ func (a AssertableAny) IsNotEqualTo(expected interface{}) AssertableAny {
 // IsNil asserts if the expected value is nil.
 func (a AssertableAny) IsNil() AssertableAny {
 	if !a.actual.IsNil() {
-		a.t.Error(shouldBeNil(a.actual))
+		a.error(shouldBeNil(a.actual))
 	}
 	return a
 }
@@ -51,7 +55,7 @@ func (a AssertableAny) IsNil() AssertableAny {
 // IsNotNil asserts if the expected value is not nil.
 func (a AssertableAny) IsNotNil() AssertableAny {
 	if !a.actual.IsNotNil() {
-		a.t.Error(shouldNotBeNil(a.actual))
+		a.error(shouldNotBeNil(a.actual))
 	}
 	return a
 }
@@ -71,7 +75,37 @@ func (a AssertableAny) IsFalse() AssertableAny {
 // HasTypeOf asserts if the expected value has the type of a given value.
 func (a AssertableAny) HasTypeOf(t reflect.Type) AssertableAny {
 	if !a.actual.HasTypeOf(t) {
-		a.t.Error(shouldHaveType(a.actual, t))
+		a.error(shouldHaveType(a.actual, t))
+	}
+	return a
+}
+
+// IsComparable asserts if the type of the expected value supports the == and != operators
+// Values of such types can be compared directly while others, such as slices, maps and functions, need reflection.
+func (a AssertableAny) IsComparable() AssertableAny {
+	if !a.actual.IsComparable() {
+		a.error(shouldBeComparable(a.actual))
+	}
+	return a
+}
+
+// IsNotComparable asserts if the type of the expected value doesn't support the == and != operators.
+func (a AssertableAny) IsNotComparable() AssertableAny {
+	if a.actual.IsComparable() {
+		a.error(shouldNotBeComparable(a.actual))
 	}
 	return a
 }
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableAny) WithMessage(message string) AssertableAny {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableAny) AsRequirement() AssertableAny {
+	a.requirement = true
+	return a
+}
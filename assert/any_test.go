@@ -193,3 +193,86 @@ func TestAssertableAny_HasTypeOf(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertableAny_IsComparable(t *testing.T) {
+	type comparableStruct struct {
+		ID   int
+		Name string
+	}
+	type nonComparableStruct struct {
+		Tags []string
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		shouldFail bool
+	}{
+		{
+			name:   "should assert comparable basic type",
+			actual: 12,
+		},
+		{
+			name:   "should assert comparable struct",
+			actual: comparableStruct{ID: 1},
+		},
+		{
+			name:   "should assert comparable pointer",
+			actual: &nonComparableStruct{},
+		},
+		{
+			name:   "should assert nil",
+			actual: nil,
+		},
+		{
+			name:       "should fail for slice",
+			actual:     []int{1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for map",
+			actual:     map[string]int{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for struct with non-comparable field",
+			actual:     nonComparableStruct{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			That(test, tt.actual).IsComparable()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableAny_IsNotComparable(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		shouldFail bool
+	}{
+		{
+			name:   "should assert slice",
+			actual: []int{1},
+		},
+		{
+			name:   "should assert function",
+			actual: func() {},
+		},
+		{
+			name:       "should fail for comparable type",
+			actual:     "123",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			That(test, tt.actual).IsNotComparable()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package assert
+
+// TestingT is the interface of the test reference the assertions report their failures to. *testing.T implements it,
+// and other implementations, such as recorders, can be used through NewAssertions.
+// If the test reference also has a Fatal(args ...interface{}) method, requirements use it to stop the test.
+type TestingT interface {
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// assertion holds the test reference and the state shared by all the assertable structures.
+type assertion struct {
+	t             TestingT
+	customMessage string
+	requirement   bool
+}
+
+// error errors the test with the given assertion error message, prefixed by the custom message if any.
+// If the assertion is a requirement, the test is stopped immediately.
+func (a assertion) error(message string) {
+	if fatal, ok := a.t.(interface{ Fatal(args ...interface{}) }); ok && a.requirement {
+		fatal.Fatal(a.withCustomMessage(message))
+		return
+	}
+	a.t.Error(a.withCustomMessage(message))
+}
+
+func (a assertion) withCustomMessage(message string) string {
+	if a.customMessage == "" {
+		return message
+	}
+	return a.customMessage + ": " + message
+}
@@ -0,0 +1,186 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordingT is a TestingT that records the reported failures instead of failing the test.
+type recordingT struct {
+	errors []string
+	fatal  bool
+}
+
+func (r *recordingT) Error(args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// fatalRecordingT is a recordingT that also records if the test would be stopped.
+type fatalRecordingT struct {
+	recordingT
+}
+
+func (r *fatalRecordingT) Fatal(args ...interface{}) {
+	r.Error(args...)
+	r.fatal = true
+}
+
+func TestAssertion_withCustomMessage(t *testing.T) {
+	tests := []struct {
+		name            string
+		customMessage   string
+		message         string
+		expectedMessage string
+	}{
+		{
+			name:            "should keep message without custom message",
+			message:         "assertion failed: boom",
+			expectedMessage: "assertion failed: boom",
+		},
+		{
+			name:            "should prefix message with custom message",
+			customMessage:   "parsing user id",
+			message:         "assertion failed: boom",
+			expectedMessage: "parsing user id: assertion failed: boom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualMessage := assertion{t: t, customMessage: tt.customMessage}.withCustomMessage(tt.message)
+			ThatString(t, actualMessage).IsEqualTo(tt.expectedMessage)
+		})
+	}
+}
+
+func TestWithMessage(t *testing.T) {
+	tests := []struct {
+		name           string
+		assert         func(a assertion)
+		expectedPrefix string
+	}{
+		{
+			name: "should prefix string errors",
+			assert: func(a assertion) {
+				thatString(a, "42").WithMessage("parsing user id").IsEqualTo("43")
+			},
+			expectedPrefix: "parsing user id: assertion failed",
+		},
+		{
+			name: "should persist custom message across chained calls",
+			assert: func(a assertion) {
+				thatInt(a, 42).WithMessage("user id").IsEqualTo(42).IsGreaterThan(50)
+			},
+			expectedPrefix: "user id: assertion failed",
+		},
+		{
+			name: "should overwrite custom message",
+			assert: func(a assertion) {
+				thatInt(a, 42).WithMessage("first").WithMessage("second").IsZero()
+			},
+			expectedPrefix: "second: assertion failed",
+		},
+		{
+			name: "should prefix slice errors set by option",
+			assert: func(a assertion) {
+				thatSlice(a, []int{1}, WithCustomMessage("ids")).Contains(2)
+			},
+			expectedPrefix: "ids: assertion failed",
+		},
+		{
+			name: "should carry custom message to derived assertables",
+			assert: func(a assertion) {
+				thatTime(a, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).WithMessage("created at").
+					DifferenceFrom(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsLongerThan(time.Hour)
+			},
+			expectedPrefix: "created at: assertion failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingT{}
+			tt.assert(assertion{t: recorder})
+			ThatInt(t, len(recorder.errors)).IsEqualTo(1)
+			ThatBool(t, strings.HasPrefix(strings.Join(recorder.errors, ""), tt.expectedPrefix)).IsTrue()
+		})
+	}
+}
+
+func TestWithMessage_FailureStillErrors(t *testing.T) {
+	test := &testing.T{}
+	ThatTime(test, time.Time{}).WithMessage("created at").IsDefined()
+	ThatBool(t, test.Failed()).IsTrue()
+}
+
+func TestAsRequirement(t *testing.T) {
+	tests := []struct {
+		name       string
+		assert     func(a assertion)
+		shouldFail bool
+		shouldStop bool
+	}{
+		{
+			name: "should error the test after a failed assertion",
+			assert: func(a assertion) {
+				thatString(a, "a").IsEqualTo("b")
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should stop the test after a failed requirement",
+			assert: func(a assertion) {
+				thatString(a, "a").AsRequirement().IsEqualTo("b")
+			},
+			shouldFail: true,
+			shouldStop: true,
+		},
+		{
+			name: "should stop the test after a failed time requirement",
+			assert: func(a assertion) {
+				thatTime(a, time.Time{}).AsRequirement().IsDefined()
+			},
+			shouldFail: true,
+			shouldStop: true,
+		},
+		{
+			name: "should not stop the test after a passed requirement",
+			assert: func(a assertion) {
+				thatInt(a, 1).AsRequirement().IsEqualTo(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fatalRecordingT{}
+			tt.assert(assertion{t: recorder})
+			ThatBool(t, len(recorder.errors) > 0).IsEqualTo(tt.shouldFail)
+			ThatBool(t, recorder.fatal).IsEqualTo(tt.shouldStop)
+		})
+	}
+}
+
+func TestAsRequirement_WithoutFatal(t *testing.T) {
+	recorder := &recordingT{}
+	thatString(assertion{t: recorder}, "a").AsRequirement().IsEqualTo("b")
+	ThatInt(t, len(recorder.errors)).IsEqualTo(1)
+}
+
+func TestAsRequirement_StopsTheTest(t *testing.T) {
+	test := &testing.T{}
+	stopped := true
+	done := make(chan struct{})
+	// t.Fatal stops the goroutine it's called on, so the requirement runs on its own one.
+	go func() {
+		defer close(done)
+		ThatString(test, "a").AsRequirement().IsEqualTo("b")
+		stopped = false
+	}()
+	<-done
+	ThatBool(t, test.Failed()).IsTrue()
+	ThatBool(t, stopped).IsTrue()
+}
@@ -0,0 +1,83 @@
+package assert
+
+import "time"
+
+// Assertions creates assertable structures reporting their failures to any TestingT, such as a recorder used to test
+// custom assertion helpers, instead of a *testing.T.
+type Assertions struct {
+	t TestingT
+}
+
+// NewAssertions creates a new Assertions reporting the failures of the assertions created by it to the given test
+// reference.
+func NewAssertions(t TestingT) Assertions {
+	return Assertions{
+		t: t,
+	}
+}
+
+// That initializes an assertable object reporting to the test reference to be used for asserting properties of any
+// type.
+func (a Assertions) That(actual interface{}) AssertableAny {
+	return that(assertion{t: a.t}, actual)
+}
+
+// ThatBool initializes an assertable bool reporting to the test reference.
+func (a Assertions) ThatBool(actual bool) AssertableBool {
+	return thatBool(assertion{t: a.t}, actual)
+}
+
+// ThatChannel initializes an assertable channel reporting to the test reference.
+func (a Assertions) ThatChannel(ch interface{}, opts ...ChannelOpt) AssertableChannel {
+	return thatChannel(assertion{t: a.t}, ch, opts...)
+}
+
+// ThatDuration initializes an assertable time.Duration reporting to the test reference.
+func (a Assertions) ThatDuration(actual time.Duration) AssertableDuration {
+	return thatDuration(assertion{t: a.t}, actual)
+}
+
+// ThatError initializes an assertable error reporting to the test reference.
+func (a Assertions) ThatError(actual error) AssertableError {
+	return thatError(assertion{t: a.t}, actual)
+}
+
+// ThatFloat64 initializes an assertable float64 reporting to the test reference.
+func (a Assertions) ThatFloat64(actual float64, opts ...FloatOpt) AssertableFloat64 {
+	return thatFloat64(assertion{t: a.t}, actual, opts...)
+}
+
+// ThatInt initializes an assertable int reporting to the test reference.
+func (a Assertions) ThatInt(actual int) AssertableInt {
+	return thatInt(assertion{t: a.t}, actual)
+}
+
+// ThatMap initializes an assertable map reporting to the test reference.
+func (a Assertions) ThatMap(actual interface{}) AssertableMap {
+	return thatMap(assertion{t: a.t}, actual)
+}
+
+// ThatPanics calls the given function and initializes an assertable panic outcome reporting to the test reference.
+func (a Assertions) ThatPanics(fn func()) AssertablePanic {
+	return thatPanics(assertion{t: a.t}, fn)
+}
+
+// ThatSlice initializes an assertable slice reporting to the test reference.
+func (a Assertions) ThatSlice(actual interface{}, opts ...SliceOpt) AssertableSlice {
+	return thatSlice(assertion{t: a.t}, actual, opts...)
+}
+
+// ThatString initializes an assertable string reporting to the test reference.
+func (a Assertions) ThatString(actual string, opts ...StringOpt) AssertableString {
+	return thatString(assertion{t: a.t}, actual, opts...)
+}
+
+// ThatStruct initializes an assertable struct reporting to the test reference.
+func (a Assertions) ThatStruct(actual interface{}) AssertableStruct {
+	return thatStruct(assertion{t: a.t}, actual)
+}
+
+// ThatTime initializes an assertable time.Time reporting to the test reference.
+func (a Assertions) ThatTime(actual time.Time, opts ...TimeOpt) AssertableTime {
+	return thatTime(assertion{t: a.t}, actual, opts...)
+}
@@ -0,0 +1,30 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAssertions(t *testing.T) {
+	recorder := &recordingT{}
+	assertions := NewAssertions(recorder)
+
+	assertions.ThatString("a").IsEqualTo("a")
+	assertions.ThatInt(1).IsEqualTo(1)
+	assertions.ThatTime(time.Unix(0, 1)).IsDefined()
+	ThatSlice(t, recorder.errors).IsEmpty()
+
+	assertions.ThatString("a").IsEqualTo("b")
+	assertions.ThatBool(false).WithMessage("enabled").IsTrue()
+	ThatSlice(t, recorder.errors).IsEqualTo([]string{
+		shouldBeEqual(thatString(assertion{}, "a").actual, "b"),
+		"enabled: " + shouldBeEqual(thatBool(assertion{}, false).actual, true),
+	})
+	ThatBool(t, recorder.fatal).IsFalse()
+}
+
+func TestAssertions_Requirement(t *testing.T) {
+	recorder := &fatalRecordingT{}
+	NewAssertions(recorder).ThatBool(false).AsRequirement().IsTrue()
+	ThatBool(t, recorder.fatal).IsTrue()
+}
@@ -8,16 +8,20 @@ import (
 
 // AssertableBool is the assertable structure for bool values.
 type AssertableBool struct {
-	t      *testing.T
+	assertion
 	actual values.BoolValue
 }
 
 // ThatBool returns an AssertableBool structure initialized with the test reference and the actual bool value to assert.
 func ThatBool(t *testing.T, actual bool) AssertableBool {
 	t.Helper()
+	return thatBool(assertion{t: t}, actual)
+}
+
+func thatBool(a assertion, actual bool) AssertableBool {
 	return AssertableBool{
-		t:      t,
-		actual: values.NewBoolValue(actual),
+		assertion: a,
+		actual:    values.NewBoolValue(actual),
 	}
 }
 
@@ -25,7 +29,7 @@ func ThatBool(t *testing.T, actual bool) AssertableBool {
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableBool) IsEqualTo(expected interface{}) AssertableBool {
 	if !a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -34,7 +38,7 @@ func (a AssertableBool) IsEqualTo(expected interface{}) AssertableBool {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableBool) IsNotEqualTo(expected interface{}) AssertableBool {
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -48,3 +52,16 @@ func (a AssertableBool) IsTrue() AssertableBool {
 func (a AssertableBool) IsFalse() AssertableBool {
 	return a.IsEqualTo(false)
 }
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableBool) WithMessage(message string) AssertableBool {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableBool) AsRequirement() AssertableBool {
+	a.requirement = true
+	return a
+}
@@ -0,0 +1,110 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
+)
+
+// AssertableChannel is the assertable structure for channel values.
+type AssertableChannel struct {
+	assertion
+	actual  values.ChannelValue
+	timeout time.Duration
+}
+
+// ChannelOpt is a configuration option to initialize an AssertableChannel.
+type ChannelOpt func(*AssertableChannel)
+
+// WithReceiveTimeout sets how long ReceivesValue waits for a value to arrive. By default it waits one second.
+func WithReceiveTimeout(timeout time.Duration) ChannelOpt {
+	return func(c *AssertableChannel) {
+		c.timeout = timeout
+	}
+}
+
+// ThatChannel returns an AssertableChannel structure initialized with the test reference and the actual channel to
+// assert. The channel can have any element type.
+func ThatChannel(t *testing.T, ch interface{}, opts ...ChannelOpt) AssertableChannel {
+	t.Helper()
+	return thatChannel(assertion{t: t}, ch, opts...)
+}
+
+func thatChannel(a assertion, ch interface{}, opts ...ChannelOpt) AssertableChannel {
+	assertable := &AssertableChannel{
+		assertion: a,
+		actual:    values.NewChannelValue(ch),
+		timeout:   time.Second,
+	}
+	for _, opt := range opts {
+		opt(assertable)
+	}
+	return *assertable
+}
+
+// ReceivesWithin asserts if a value is received from the assertable channel before the given duration elapses. The
+// received value is consumed.
+// It errors the test if the value is not a receivable channel, the channel is closed or no value arrives in time.
+func (a AssertableChannel) ReceivesWithin(d time.Duration) AssertableChannel {
+	a.receiveWithin(d)
+	return a
+}
+
+// ReceivesValue asserts if the next value received from the assertable channel, within the timeout set by
+// WithReceiveTimeout, is deeply equal to the expected one, using reflect.DeepEqual. The received value is consumed.
+// It errors the test if the value is not a receivable channel, the channel is closed, no value arrives in time or the
+// received value is different.
+func (a AssertableChannel) ReceivesValue(expected interface{}) AssertableChannel {
+	if value, ok := a.receiveWithin(a.timeout); ok && !reflect.DeepEqual(value, expected) {
+		a.error(shouldReceiveValue(expected, value))
+	}
+	return a
+}
+
+// IsClosed asserts if the assertable channel is closed and drained, checking it without blocking. A value ready to be
+// received is consumed.
+// It errors the test if the value is not a receivable channel or the channel is not closed.
+func (a AssertableChannel) IsClosed() AssertableChannel {
+	if !a.actual.IsReceivable() {
+		a.error(shouldBeReceivableChannel(a.actual))
+		return a
+	}
+	if value, received, empty := a.actual.TryReceive(); received {
+		a.error(shouldBeDrainedChannel(value))
+	} else if empty {
+		a.error(shouldBeClosedChannel())
+	}
+	return a
+}
+
+func (a AssertableChannel) receiveWithin(d time.Duration) (interface{}, bool) {
+	if !a.actual.IsReceivable() {
+		a.error(shouldBeReceivableChannel(a.actual))
+		return nil, false
+	}
+	value, received, timedOut := a.actual.ReceiveWithin(d)
+	if timedOut {
+		a.error(shouldReceiveWithin(d))
+		return nil, false
+	}
+	if !received {
+		a.error(shouldReceiveFromOpenChannel())
+		return nil, false
+	}
+	return value, true
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableChannel) WithMessage(message string) AssertableChannel {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableChannel) AsRequirement() AssertableChannel {
+	a.requirement = true
+	return a
+}
@@ -0,0 +1,205 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAssertableChannel_ReceivesWithin(t *testing.T) {
+	tests := []struct {
+		name       string
+		channel    func() interface{}
+		shouldFail bool
+	}{
+		{
+			name: "should assert buffered channel with value",
+			channel: func() interface{} {
+				ch := make(chan int, 1)
+				ch <- 1
+				return ch
+			},
+		},
+		{
+			name: "should assert unbuffered channel with sender",
+			channel: func() interface{} {
+				ch := make(chan string)
+				go func() { ch <- "done" }()
+				return ch
+			},
+		},
+		{
+			name: "should assert receive-only channel",
+			channel: func() interface{} {
+				ch := make(chan int, 1)
+				ch <- 1
+				return (<-chan int)(ch)
+			},
+		},
+		{
+			name: "should fail for empty buffered channel",
+			channel: func() interface{} {
+				return make(chan int, 1)
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for unbuffered channel without sender",
+			channel: func() interface{} {
+				return make(chan int)
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for closed channel",
+			channel: func() interface{} {
+				ch := make(chan int)
+				close(ch)
+				return ch
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for send-only channel",
+			channel: func() interface{} {
+				return make(chan<- int, 1)
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for non-channel value",
+			channel: func() interface{} {
+				return 1
+			},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatChannel(test, tt.channel()).ReceivesWithin(10 * time.Millisecond)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableChannel_ReceivesValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		channel    func() interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name: "should assert value of buffered channel",
+			channel: func() interface{} {
+				ch := make(chan []int, 1)
+				ch <- []int{1, 2}
+				return ch
+			},
+			expected: []int{1, 2},
+		},
+		{
+			name: "should assert value of unbuffered channel",
+			channel: func() interface{} {
+				ch := make(chan string)
+				go func() { ch <- "done" }()
+				return ch
+			},
+			expected: "done",
+		},
+		{
+			name: "should fail for different value",
+			channel: func() interface{} {
+				ch := make(chan string, 1)
+				ch <- "failed"
+				return ch
+			},
+			expected:   "done",
+			shouldFail: true,
+		},
+		{
+			name: "should fail for value of other type",
+			channel: func() interface{} {
+				ch := make(chan int64, 1)
+				ch <- 1
+				return ch
+			},
+			expected:   1,
+			shouldFail: true,
+		},
+		{
+			name: "should fail if no value arrives in time",
+			channel: func() interface{} {
+				return make(chan string)
+			},
+			expected:   "done",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatChannel(test, tt.channel(), WithReceiveTimeout(10*time.Millisecond)).ReceivesValue(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableChannel_IsClosed(t *testing.T) {
+	tests := []struct {
+		name       string
+		channel    func() interface{}
+		shouldFail bool
+	}{
+		{
+			name: "should assert closed unbuffered channel",
+			channel: func() interface{} {
+				ch := make(chan int)
+				close(ch)
+				return ch
+			},
+		},
+		{
+			name: "should assert closed and drained buffered channel",
+			channel: func() interface{} {
+				ch := make(chan int, 1)
+				ch <- 1
+				<-ch
+				close(ch)
+				return ch
+			},
+		},
+		{
+			name: "should fail for closed buffered channel with value",
+			channel: func() interface{} {
+				ch := make(chan int, 1)
+				ch <- 1
+				close(ch)
+				return ch
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for open channel",
+			channel: func() interface{} {
+				return make(chan int)
+			},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for nil channel",
+			channel: func() interface{} {
+				var ch chan int
+				return ch
+			},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatChannel(test, tt.channel()).IsClosed()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
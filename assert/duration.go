@@ -9,16 +9,20 @@ import (
 
 // AssertableDuration is the assertable structure for time.Duration values.
 type AssertableDuration struct {
-	t      *testing.T
+	assertion
 	actual values.DurationValue
 }
 
 // ThatDuration returns an AssertableDuration structure initialized with the test reference and the actual value to assert.
 func ThatDuration(t *testing.T, actual time.Duration) AssertableDuration {
 	t.Helper()
+	return thatDuration(assertion{t: t}, actual)
+}
+
+func thatDuration(a assertion, actual time.Duration) AssertableDuration {
 	return AssertableDuration{
-		t:      t,
-		actual: values.NewDurationValue(actual),
+		assertion: a,
+		actual:    values.NewDurationValue(actual),
 	}
 }
 
@@ -26,7 +30,7 @@ func ThatDuration(t *testing.T, actual time.Duration) AssertableDuration {
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableDuration) IsEqualTo(expected time.Duration) AssertableDuration {
 	if a.actual.IsNotEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -35,7 +39,7 @@ func (a AssertableDuration) IsEqualTo(expected time.Duration) AssertableDuration
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableDuration) IsNotEqualTo(expected time.Duration) AssertableDuration {
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -44,7 +48,7 @@ func (a AssertableDuration) IsNotEqualTo(expected time.Duration) AssertableDurat
 // It errors the tests if is not shorter.
 func (a AssertableDuration) IsShorterThan(expected time.Duration) AssertableDuration {
 	if !a.actual.IsShorterThan(expected) {
-		a.t.Error(shouldBeShorter(a.actual, expected))
+		a.error(shouldBeShorter(a.actual, expected))
 	}
 	return a
 }
@@ -53,7 +57,20 @@ func (a AssertableDuration) IsShorterThan(expected time.Duration) AssertableDura
 // It errors the tests if is not longer.
 func (a AssertableDuration) IsLongerThan(expected time.Duration) AssertableDuration {
 	if !a.actual.IsLongerThan(expected) {
-		a.t.Error(shouldBeLonger(a.actual, expected))
+		a.error(shouldBeLonger(a.actual, expected))
 	}
 	return a
 }
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableDuration) WithMessage(message string) AssertableDuration {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableDuration) AsRequirement() AssertableDuration {
+	a.requirement = true
+	return a
+}
@@ -1,6 +1,10 @@
 package assert
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
@@ -8,16 +12,20 @@ import (
 
 // AssertableError is the assertable structure for error values.
 type AssertableError struct {
-	t      *testing.T
+	assertion
 	actual values.ErrorValue
 }
 
 // ThatError returns an AssertableError structure initialized with the test reference and the actual value to assert.
 func ThatError(t *testing.T, actual error) AssertableError {
 	t.Helper()
+	return thatError(assertion{t: t}, actual)
+}
+
+func thatError(a assertion, actual error) AssertableError {
 	return AssertableError{
-		t:      t,
-		actual: values.NewErrorValue(actual),
+		assertion: a,
+		actual:    values.NewErrorValue(actual),
 	}
 }
 
@@ -25,7 +33,7 @@ func ThatError(t *testing.T, actual error) AssertableError {
 func (a AssertableError) IsNil() AssertableError {
 	errAnyValue := values.NewAnyValue(a.actual.Value())
 	if errAnyValue.IsNotNil() {
-		a.t.Error(shouldBeNil(errAnyValue))
+		a.error(shouldBeNil(errAnyValue))
 	}
 	return a
 }
@@ -34,7 +42,7 @@ func (a AssertableError) IsNil() AssertableError {
 func (a AssertableError) IsNotNil() AssertableError {
 	errAnyValue := values.NewAnyValue(a.actual.Value())
 	if errAnyValue.IsNil() {
-		a.t.Error(shouldNotBeNil(errAnyValue))
+		a.error(shouldNotBeNil(errAnyValue))
 	}
 	return a
 }
@@ -43,13 +51,70 @@ func (a AssertableError) IsNotNil() AssertableError {
 func (a AssertableError) HasExactMessage(expectedMessage string) AssertableError {
 	errAnyValue := values.NewAnyValue(a.actual.Value())
 	if errAnyValue.IsNil() {
-		a.t.Error(shouldContain(errAnyValue, expectedMessage))
+		a.error(shouldContain(errAnyValue, expectedMessage))
 		return a
 	}
 
 	errStringValue := values.NewStringValue(a.actual.Error().Error())
 	if !errStringValue.ContainsOnly(expectedMessage) {
-		a.t.Error(shouldContain(errAnyValue, expectedMessage))
+		a.error(shouldContain(errAnyValue, expectedMessage))
+	}
+	return a
+}
+
+// HasMessage asserts if the message of the expected error is equal to the given message.
+// It errors the test if the error is nil or its message is different.
+func (a AssertableError) HasMessage(msg string) AssertableError {
+	if values.NewAnyValue(a.actual.Value()).IsNil() {
+		a.error(shouldBeErrorWithMessage("equal to", msg))
+		return a
+	}
+	if a.actual.Error().Error() != msg {
+		a.error(shouldHaveErrorMessage(a.actual, "equal to", msg))
+	}
+	return a
+}
+
+// HasMessageContaining asserts if the message of the expected error contains the given substring.
+// It errors the test if the error is nil or its message doesn't contain the substring.
+func (a AssertableError) HasMessageContaining(substr string) AssertableError {
+	if values.NewAnyValue(a.actual.Value()).IsNil() {
+		a.error(shouldBeErrorWithMessage("containing", substr))
+		return a
+	}
+	if !strings.Contains(a.actual.Error().Error(), substr) {
+		a.error(shouldHaveErrorMessage(a.actual, "containing", substr))
+	}
+	return a
+}
+
+// Is asserts if the expected error or any error in its chain matches the given target error according to errors.Is.
+// It errors the test if no error in the chain matches the target.
+func (a AssertableError) Is(target error) AssertableError {
+	if !errors.Is(a.actual.Error(), target) {
+		a.error(shouldBeErrorInChain(a.actual, target))
+	}
+	return a
+}
+
+// As asserts if any error in the chain of the expected error can be assigned to the given target, as errors.As does.
+// The target must be a non-nil pointer to an error type or an interface type. On success the target is set to the
+// first matching error of the chain, so it can be inspected further.
+// It errors the test if the error is nil, the target is not valid or no error in the chain matches the target type.
+func (a AssertableError) As(target interface{}) AssertableError {
+	targetType := reflect.TypeOf(target)
+	if targetType == nil || targetType.Kind() != reflect.Ptr || reflect.ValueOf(target).IsNil() ||
+		(targetType.Elem().Kind() != reflect.Interface && !targetType.Elem().Implements(reflect.TypeOf((*error)(nil)).Elem())) {
+		a.error(shouldBeValidErrorTarget(target))
+		return a
+	}
+	errAnyValue := values.NewAnyValue(a.actual.Value())
+	if errAnyValue.IsNil() {
+		a.error(shouldNotBeNil(errAnyValue))
+		return a
+	}
+	if !errors.As(a.actual.Error(), target) {
+		a.error(shouldBeErrorAs(a.actual, targetType.Elem()))
 	}
 	return a
 }
@@ -60,7 +125,7 @@ func (a AssertableError) IsSameAs(err error) AssertableError {
 	expectedAnyValue := values.NewAnyValue(err)
 
 	if actualAnyValue.IsNil() != expectedAnyValue.IsNil() {
-		a.t.Error(shouldBeEqual(a.actual, expectedAnyValue))
+		a.error(shouldBeEqual(a.actual, expectedAnyValue))
 		return a
 	}
 	if actualAnyValue.IsNil() && expectedAnyValue.IsNil() {
@@ -69,7 +134,129 @@ func (a AssertableError) IsSameAs(err error) AssertableError {
 
 	actualStringValue := values.NewStringValue(a.actual.Error().Error())
 	if !actualStringValue.IsEqualTo(err.Error()) {
-		a.t.Error(shouldBeEqual(a.actual, err.Error()))
+		a.error(shouldBeEqual(a.actual, err.Error()))
+	}
+	return a
+}
+
+// IsOfType asserts if the dynamic type of the expected error is the same as the type of the given error exemplar
+// Unlike errors.Is and errors.As the wrapped errors are not inspected, only the concrete type of the error itself.
+func (a AssertableError) IsOfType(target error) AssertableError {
+	if reflect.TypeOf(a.actual.Value()) != reflect.TypeOf(target) {
+		a.error(shouldBeOfErrorType(a.actual, target))
+	}
+	return a
+}
+
+// HasJoinedErrorCount asserts if the expected error joins exactly the given number of errors through the
+// Unwrap() []error method, as the errors created by errors.Join do.
+func (a AssertableError) HasJoinedErrorCount(n int) AssertableError {
+	errAnyValue := values.NewAnyValue(a.actual.Value())
+	if errAnyValue.IsNil() {
+		a.error(shouldNotBeNil(errAnyValue))
+		return a
+	}
+	if joined := a.actual.JoinedErrors(); len(joined) != n {
+		a.error(shouldHaveJoinedErrorCount(a.actual, n, joined))
+	}
+	return a
+}
+
+// JoinedErrorsContain asserts if any of the errors joined in the expected error through the Unwrap() []error method
+// matches the given target error according to errors.Is.
+func (a AssertableError) JoinedErrorsContain(target error) AssertableError {
+	errAnyValue := values.NewAnyValue(a.actual.Value())
+	if errAnyValue.IsNil() {
+		a.error(shouldNotBeNil(errAnyValue))
+		return a
+	}
+	joined := a.actual.JoinedErrors()
+	for _, err := range joined {
+		if errors.Is(err, target) {
+			return a
+		}
+	}
+	a.error(shouldHaveJoinedError(a.actual, target, joined))
+	return a
+}
+
+// StackTraceContains asserts if the stack trace carried by the expected error, or by the first error in its chain that
+// carries one, has a frame containing the given substring. Errors carry a stack trace if they expose a StackTrace()
+// method returning a slice of frames, as the errors of github.com/pkg/errors do.
+// It errors the test if
+// * the error doesn't carry a stack trace
+// * no frame of the stack trace contains the given substring.
+func (a AssertableError) StackTraceContains(frameSubstring string) AssertableError {
+	frames, ok := a.actual.StackTrace()
+	if !ok {
+		a.error(shouldHaveStackTrace(a.actual))
+		return a
+	}
+	for _, frame := range frames {
+		if strings.Contains(frame, frameSubstring) {
+			return a
+		}
+	}
+	a.error(shouldHaveStackTraceFrame(a.actual, frameSubstring, frames))
+	return a
+}
+
+// VerboseFormatContains asserts if the expected error formatted with the %+v verb contains the given substring.
+// Error types implementing fmt.Formatter, such as the errors of github.com/pkg/errors, may include more context like
+// stack traces in their verbose format than in their Error() message.
+// It errors the test if the error is nil or its verbose format doesn't contain the substring.
+func (a AssertableError) VerboseFormatContains(substring string) AssertableError {
+	errAnyValue := values.NewAnyValue(a.actual.Value())
+	if errAnyValue.IsNil() {
+		a.error(shouldNotBeNil(errAnyValue))
+		return a
+	}
+	if verbose := fmt.Sprintf("%+v", a.actual.Error()); !strings.Contains(verbose, substring) {
+		a.error(shouldHaveVerboseFormatContaining(substring, verbose))
 	}
 	return a
 }
+
+// IsTimeout asserts if the expected error, or an error in its chain, exposes a Timeout() bool method as net.Error does
+// and reports a timeout.
+// It errors the test if the error is nil, no error in its chain exposes the method or the method returns false.
+func (a AssertableError) IsTimeout() AssertableError {
+	return a.isClassifiedAs("timeout", "Timeout()", values.ErrorValue.IsTimeout)
+}
+
+// IsTemporary asserts if the expected error, or an error in its chain, exposes a Temporary() bool method as net.Error
+// does and reports a temporary failure.
+// It errors the test if the error is nil, no error in its chain exposes the method or the method returns false.
+func (a AssertableError) IsTemporary() AssertableError {
+	return a.isClassifiedAs("temporary", "Temporary()", values.ErrorValue.IsTemporary)
+}
+
+func (a AssertableError) isClassifiedAs(classification, method string, classify func(values.ErrorValue) (bool, bool)) AssertableError {
+	errAnyValue := values.NewAnyValue(a.actual.Value())
+	if errAnyValue.IsNil() {
+		a.error(shouldNotBeNil(errAnyValue))
+		return a
+	}
+	classified, classifiable := classify(a.actual)
+	if !classifiable {
+		a.error(shouldBeClassifiable(a.actual, classification, method))
+		return a
+	}
+	if !classified {
+		a.error(shouldBeClassifiedAs(a.actual, classification, method))
+	}
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableError) WithMessage(message string) AssertableError {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableError) AsRequirement() AssertableError {
+	a.requirement = true
+	return a
+}
@@ -1,11 +1,15 @@
 package assert
 
 import (
+	"crypto"
 	"fmt"
+	"math"
 	"reflect"
 	"strings"
+	"time"
 
 	utils2 "github.com/ppapapetrou76/go-testing/internal/pkg/utils"
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
 	"github.com/ppapapetrou76/go-testing/types"
 	"github.com/r3labs/diff/v2"
 )
@@ -33,10 +37,83 @@ func shouldBeEqual(actual types.Assertable, expected interface{}) string {
 			}
 		}
 	}
+	if expectedString, ok := expected.(string); ok {
+		if stringValue, ok := actual.(values.StringValue); ok {
+			diffMessage.WriteString(stringDiff(stringValue.Decorate(expectedString), stringValue.DecoratedValue()))
+		} else if actualString, ok := actual.Value().(string); ok {
+			diffMessage.WriteString(stringDiff(expectedString, actualString))
+		}
+	}
 
 	return fmt.Sprintf("assertion failed:\nexpected value\t:%+v\nactual value\t:%+v\n%s", expected, actual.Value(), diffMessage.String())
 }
 
+const (
+	// stringDiffMinLength is the length in runes from which a string is long enough to get a string diff in the
+	// failure messages of equality assertions.
+	stringDiffMinLength = 64
+	// stringDiffContext is the number of runes shown on each side of the first difference of two strings.
+	stringDiffContext = 20
+)
+
+// stringDiff returns a snippet of the line holding the first difference of two long or multi-line strings, with a
+// caret pointing at the difference. It returns an empty string for equal or short single line strings.
+func stringDiff(expected, actual string) string {
+	expectedRunes, actualRunes := []rune(expected), []rune(actual)
+	if len(expectedRunes) < stringDiffMinLength && len(actualRunes) < stringDiffMinLength &&
+		!strings.Contains(expected, "\n") && !strings.Contains(actual, "\n") {
+		return ""
+	}
+
+	index := 0
+	for index < len(expectedRunes) && index < len(actualRunes) && expectedRunes[index] == actualRunes[index] {
+		index++
+	}
+	if index == len(expectedRunes) && index == len(actualRunes) {
+		return ""
+	}
+
+	line, lineStart := 1, 0
+	for i, r := range expectedRunes[:index] {
+		if r == '\n' {
+			line++
+			lineStart = i + 1
+		}
+	}
+	column := index - lineStart
+	start := column - stringDiffContext
+	if start < 0 {
+		start = 0
+	}
+	prefix := ""
+	if start > 0 {
+		prefix = "..."
+	}
+
+	return fmt.Sprintf("first difference at index %d (line %d, column %d):\nexpected: %s\nactual:   %s\n          %s^\n",
+		index, line, column+1,
+		stringDiffLine(expectedRunes[lineStart:], prefix, start),
+		stringDiffLine(actualRunes[lineStart:], prefix, start),
+		strings.Repeat(" ", len(prefix)+column-start))
+}
+
+func stringDiffLine(runes []rune, prefix string, start int) string {
+	for i, r := range runes {
+		if r == '\n' {
+			runes = runes[:i]
+			break
+		}
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+	end, suffix := len(runes), ""
+	if end-start > 2*stringDiffContext {
+		end, suffix = start+2*stringDiffContext, "..."
+	}
+	return prefix + string(runes[start:end]) + suffix
+}
+
 func shouldNotBeEqual(actual types.Assertable, expected interface{}) string {
 	return fmt.Sprintf("assertion failed: expected value of = %+v, to be other than %+v", actual.Value(), expected)
 }
@@ -65,6 +142,18 @@ func shouldNotBeEmpty(actual types.Assertable) string {
 	return fmt.Sprintf("assertion failed: expected %+v not to be empty, but it is", actual.Value())
 }
 
+func shouldBeBlank(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected %q to be empty or contain only white spaces, but it's not", actual.Value())
+}
+
+func shouldNotBeBlank(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected %q not to be empty or contain only white spaces, but it is", actual.Value())
+}
+
+func shouldNotBeNilMap(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected %T map not to be empty, but it is nil", actual.Value())
+}
+
 func shouldBeNil(actual types.Assertable) string {
 	return fmt.Sprintf("assertion failed: expected value of = %+v, to be nil but it wasn't", actual.Value())
 }
@@ -73,6 +162,14 @@ func shouldNotBeNil(actual types.Assertable) string {
 	return fmt.Sprintf("assertion failed: expected value of = %+v, to be non-nil but it was", actual.Value())
 }
 
+func shouldBeZeroTime(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be the zero time but it wasn't", actual.Value())
+}
+
+func shouldNotBeZeroTime(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be a non-zero time but it was", actual.Value())
+}
+
 func shouldHaveSize(actual types.Sizeable, expected int) string {
 	return fmt.Sprintf("assertion failed: expected size of = [%d], to be but it has size of [%d] ", actual.Size(), expected)
 }
@@ -81,6 +178,10 @@ func shouldContain(actual types.Assertable, elements interface{}) string {
 	return fmt.Sprintf("assertion failed: containable [%v] should contain [%+v], but it doesn't", actual.Value(), elements)
 }
 
+func shouldContainAnyOf(actual types.Assertable, elements interface{}) string {
+	return fmt.Sprintf("assertion failed: containable [%v] should contain any of [%+v], but it doesn't", actual.Value(), elements)
+}
+
 func shouldContainIgnoringCase(actual types.Assertable, elements interface{}) string {
 	return fmt.Sprintf("assertion failed: containable [%v] should contain [%+v] ignoring case, but it doesn't", actual.Value(), elements)
 }
@@ -109,6 +210,10 @@ func shouldBeMap(actual types.Assertable) string {
 	return fmt.Sprintf("assertion failed: assertable should be a map but it is %T", reflect.ValueOf(actual.Value()).Kind())
 }
 
+func shouldBeSlice(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: assertable should be a slice but it is %T", actual.Value())
+}
+
 func shouldHaveKey(actual types.Assertable, elements interface{}) string {
 	return fmt.Sprintf("assertion failed: map [%v] should have the key [%+v], but it doesn't", actual.Value(), elements)
 }
@@ -145,10 +250,18 @@ func shouldEndWith(actual types.Assertable, substr string) string {
 	return fmt.Sprintf("assertion failed: expected value of [%v] to end with [%+v], but it doesn't", actual.Value(), substr)
 }
 
+func shouldHaveAffixOneOf(actual types.Assertable, position string, candidates []string) string {
+	return fmt.Sprintf("assertion failed: expected value of [%v] to %s with one of %q, but it doesn't", actual.Value(), position, candidates)
+}
+
 func shouldNotEndWith(actual types.Assertable, substr string) string {
 	return fmt.Sprintf("assertion failed: expected value of [%v] to not end with [%+v], but it does", actual.Value(), substr)
 }
 
+func shouldHaveLength(actual types.Assertable, length int) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have length [%d], but it has length [%d]", actual.Value(), length, actual.(types.Sizeable).Size())
+}
+
 func shouldHaveSameSizeAs(actual types.Assertable, substr string) string {
 	return fmt.Sprintf("assertion failed: expected size of [%v] should be same as the size of [%+v], but it isn't", actual.Value(), substr)
 }
@@ -158,7 +271,7 @@ func shouldHaveType(actual types.Assertable, value interface{}) string {
 }
 
 func shouldBeShorter(actual types.Assertable, expected interface{}) string {
-	return fmt.Sprintf("assertion failed: expected value of = %+v, to be greater than %+v", actual.Value(), expected)
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be shorter than %+v", actual.Value(), expected)
 }
 
 func shouldBeLonger(actual types.Assertable, expected interface{}) string {
@@ -188,3 +301,510 @@ func shouldBeDefined(actual types.Assertable) string {
 func shouldNotBeDefined(actual types.Assertable) string {
 	return fmt.Sprintf("assertion failed: expected value of = %+v, to be un-defined but it was", actual.Value())
 }
+
+func shouldBeValidMACAddress(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be a valid MAC address in the form of 00:00:5e:00:53:01 or 00-00-5e-00-53-01, but it's not: %s", actual.Value(), err)
+}
+
+func shouldHavePositiveSize(size int) string {
+	return fmt.Sprintf("assertion failed: expected size to be greater than zero, but it is [%d]", size)
+}
+
+func shouldBeValidBase32(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be a valid base32 encoded value, but it's not: %s", actual.Value(), err)
+}
+
+func shouldDecodeTo(actual types.Assertable, expected, decoded string) string {
+	return fmt.Sprintf("assertion failed: expected %+v to decode to [%s], but it decodes to [%s]", actual.Value(), expected, decoded)
+}
+
+func shouldBeParsableTime(value, layout string, err error) string {
+	return fmt.Sprintf("assertion failed: expected [%s] to be a time value of layout [%s], but it's not: %s", value, layout, err)
+}
+
+func shouldHaveDigitCount(actual values.IntValue, expected int) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to have %d digit(s), but it has %d", actual.Value(), expected, actual.DigitCount())
+}
+
+func shouldBeInDigitRange(actual values.IntValue, min, max int) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to have between %d and %d digit(s), but it has %d", actual.Value(), min, max, actual.DigitCount())
+}
+
+func shouldBeEqualTrimmed(actual types.Assertable, trimmed, expected string) string {
+	return fmt.Sprintf("assertion failed:\nexpected value\t:%q\nactual value\t:%q\ntrimmed value\t:%q\n", expected, actual.Value(), trimmed)
+}
+
+func shouldBeEqualIgnoringWhitespace(actual types.Assertable, expected string) string {
+	return fmt.Sprintf("assertion failed: expected value ignoring white spaces\t:%q\nactual value\t:%q\n", expected, actual.Value())
+}
+
+func shouldBeEqualIgnoringCase(actual types.Assertable, expected string) string {
+	return fmt.Sprintf("assertion failed: expected value ignoring case\t:%q\nactual value\t:%q\n", expected, actual.Value())
+}
+
+func shouldBeEqualDedented(actual, expected string) string {
+	return fmt.Sprintf("assertion failed:\ndedented expected value\t:%q\ndedented actual value\t:%q\n", expected, actual)
+}
+
+func shouldHaveValidWindowSize(actual types.Sizeable, size int) string {
+	return fmt.Sprintf("assertion failed: expected window size to be between 1 and the slice size [%d], but it is [%d]", actual.Size(), size)
+}
+
+func shouldHaveElementAtIndexSatisfying(actual types.Assertable, index int, element interface{}) string {
+	return fmt.Sprintf("assertion failed: expected every element of %+v to satisfy the predicate, but element [%+v] at index [%d] doesn't", actual.Value(), element, index)
+}
+
+func shouldHaveAllWindowsSatisfying(actual types.Assertable, size, index int) string {
+	return fmt.Sprintf("assertion failed: expected every window of size [%d] of %+v to satisfy the predicate, but the window starting at index [%d] doesn't", size, actual.Value(), index)
+}
+
+func shouldBeMapsOfSameType(base, overlay interface{}) string {
+	return fmt.Sprintf("assertion failed: expected maps of the same type to merge, but got %T and %T", base, overlay)
+}
+
+func shouldBeNumeric(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be a number, but it's not: %s", actual.Value(), err)
+}
+
+func shouldBeValidDuration(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be a valid duration, but it's not: %s", actual.Value(), err)
+}
+
+func shouldBeOfErrorType(actual types.Assertable, target error) string {
+	return fmt.Sprintf("assertion failed: expected error [%+v] to be of type %T, but it is of type %T", actual.Value(), target, actual.Value())
+}
+
+func shouldBeDeepEqual(actual types.Assertable, expected interface{}) string {
+	changesMessage := strings.Builder{}
+	differ, _ := diff.NewDiffer(diff.SliceOrdering(true))
+	changes, err := differ.Diff(expected, actual.Value())
+	if err == nil {
+		for _, change := range changes {
+			if len(change.Path) == 0 {
+				continue
+			}
+			path := fmt.Sprintf("index [%s]", change.Path[0])
+			if len(change.Path) > 1 {
+				path += "." + strings.Join(change.Path[1:], ".")
+			}
+			changesMessage.WriteString(fmt.Sprintf("%s: %s -> %s\n", path, formatChangeValue(change.From), formatChangeValue(change.To)))
+		}
+	}
+
+	return fmt.Sprintf("assertion failed:\nexpected value\t:%+v\nactual value\t:%+v\n%s", expected, actual.Value(), changesMessage.String())
+}
+
+func formatChangeValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%+v", value)
+}
+
+func shouldBeValidRegexp(pattern string, err error) string {
+	return fmt.Sprintf("assertion failed: expected [%s] to be a valid regular expression, but it's not: %s", pattern, err)
+}
+
+func shouldHaveEachLineMatching(actual types.Assertable, pattern string, lineNumber int, line string) string {
+	return fmt.Sprintf("assertion failed: expected each line of %+v to match [%s], but line %d [%s] doesn't", actual.Value(), pattern, lineNumber, line)
+}
+
+func shouldHaveSomeLineMatching(actual types.Assertable, pattern string) string {
+	return fmt.Sprintf("assertion failed: expected at least one line of %+v to match [%s], but none does", actual.Value(), pattern)
+}
+
+func shouldNotBeZeroDivisor(actual types.Assertable, n int) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be a factor of %d, but zero is not a factor of any number", actual.Value(), n)
+}
+
+func shouldBeFactorOf(actual types.Assertable, n int) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be a factor of %d, but the remainder is %d", actual.Value(), n, n%actual.Value().(int))
+}
+
+func shouldNotBothBeZero(actual types.Assertable, n int) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be coprime with %d, but the greatest common divisor of zero and zero is undefined", actual.Value(), n)
+}
+
+func shouldBeCoprimeWith(actual types.Assertable, n, gcd int) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be coprime with %d, but their greatest common divisor is %d", actual.Value(), n, gcd)
+}
+
+func shouldHaveMatchGroup(pattern string, groupIndex, groups int) string {
+	return fmt.Sprintf("assertion failed: expected group index to be between 0 and %d for pattern [%s], but it is [%d]", groups, pattern, groupIndex)
+}
+
+func shouldMatch(actual types.Assertable, pattern string) string {
+	return fmt.Sprintf("assertion failed: expected %+v to match [%s], but it doesn't", actual.Value(), pattern)
+}
+
+func shouldHaveMatchGroupEqualTo(actual types.Assertable, pattern string, groupIndex int, expected, captured string) string {
+	return fmt.Sprintf("assertion failed: expected group %d of [%s] in %+v to be [%s], but it is [%s]", groupIndex, pattern, actual.Value(), expected, captured)
+}
+
+func shouldBeSameInstant(actual types.Assertable, expected time.Time) string {
+	return fmt.Sprintf("assertion failed: expected value of = %s, to be the same instant as %s", actual.Value().(time.Time).Format(time.RFC3339Nano), expected.Format(time.RFC3339Nano))
+}
+
+func shouldNotMatch(actual types.Assertable, pattern, match string) string {
+	return fmt.Sprintf("assertion failed: expected %+v not to match [%s], but it does with [%s]", actual.Value(), pattern, match)
+}
+
+func shouldHaveEntriesMatchingCount(actual types.Assertable, expected, count int) string {
+	return fmt.Sprintf("assertion failed: map [%v] should have %d entries satisfying the predicate, but it has %d", actual.Value(), expected, count)
+}
+
+func shouldHaveIndex(actual types.Assertable, unit string, index, length int) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have a %s at index %d, but it has %d %s(s)", actual.Value(), unit, index, length, unit)
+}
+
+func shouldHaveLine(actual types.Assertable, line, lines int) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have line %d, but it has %d line(s)", actual.Value(), line, lines)
+}
+
+func shouldBeSlices(as, bs interface{}) string {
+	return fmt.Sprintf("assertion failed: expected two slices, but got %T and %T", as, bs)
+}
+
+func shouldHaveSameSizeSlices(as, bs interface{}) string {
+	index := reflect.ValueOf(as).Len()
+	if reflect.ValueOf(bs).Len() < index {
+		index = reflect.ValueOf(bs).Len()
+	}
+	return fmt.Sprintf("assertion failed: expected %+v and %+v to have the same size, but their sizes diverge at index [%d]", as, bs, index)
+}
+
+func shouldHaveZippedElementsSatisfying(index int, a, b interface{}) string {
+	return fmt.Sprintf("assertion failed: expected the elements at index [%d] to satisfy the relation, but %+v and %+v don't", index, a, b)
+}
+
+func shouldHaveJoinedErrorCount(actual types.Assertable, expected int, joined []error) string {
+	return fmt.Sprintf("assertion failed: expected error [%+v] to join %d error(s), but it joins %d: %q", actual.Value(), expected, len(joined), errorMessages(joined))
+}
+
+func shouldHaveJoinedError(actual types.Assertable, target error, joined []error) string {
+	return fmt.Sprintf("assertion failed: expected the errors joined in [%+v] to contain [%+v], but they don't: %q", actual.Value(), target, errorMessages(joined))
+}
+
+func errorMessages(errs []error) []string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+func shouldHaveAvailableHash(hash crypto.Hash) string {
+	return fmt.Sprintf("assertion failed: expected hash function [%s] to be available, but it's not linked into the binary", hash)
+}
+
+func shouldHaveHash(actual types.Assertable, expected, hash string) string {
+	return fmt.Sprintf("assertion failed: expected hash of %+v to be [%s], but it is [%s]", actual.Value(), expected, hash)
+}
+
+func shouldRoundTo(actual types.Assertable, unit time.Duration, expected, rounded time.Time) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, rounded to %s to be %+v, but it is %+v", actual.Value(), unit, expected, rounded)
+}
+
+func shouldBeMarshalable(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be marshaled to JSON, but it can't: %s", actual.Value(), err)
+}
+
+func shouldHaveColumn(actual types.Sizeable, start, end int) string {
+	return fmt.Sprintf("assertion failed: expected column range [%d:%d] to be within the bounds of %+v of size %d, but it's not", start, end, actual.Value(), actual.Size())
+}
+
+func shouldBeComparable(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be comparable but its type %T is not", actual.Value(), actual.Value())
+}
+
+func shouldNotBeComparable(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be non-comparable but its type %T is", actual.Value(), actual.Value())
+}
+
+func shouldBeFlatMappedToSlices(flattened interface{}, mapped reflect.Value) string {
+	if !mapped.IsValid() {
+		return fmt.Sprintf("assertion failed: expected function to return slices of type %T, but it returned <nil>", flattened)
+	}
+	return fmt.Sprintf("assertion failed: expected function to return slices of type %T, but it returned %s", flattened, mapped.Type())
+}
+
+func shouldBeValidCron(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be a valid cron expression, but it's not: %s", actual.Value(), err)
+}
+
+func shouldBeInRomanNumeralRange(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be between 1 and 3999 to be written in roman numerals, but it's not", actual.Value())
+}
+
+func shouldBeValidHexColor(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be a hex color of the form #RGB, #RRGGBB or #RRGGBBAA, but it's not: %s", actual.Value(), err)
+}
+
+func shouldBeNextOccurrenceOf(actual types.Assertable, weekday time.Weekday, from, expected time.Time) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be the next %s after %+v which is on %s, but it's not", actual.Value(), weekday, from, expected.Format("2006-01-02"))
+}
+
+func shouldHaveStackTrace(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected error %+v to carry a stack trace, but it doesn't", actual.Value())
+}
+
+func shouldHaveStackTraceFrame(actual types.Assertable, frameSubstring string, frames []string) string {
+	return fmt.Sprintf("assertion failed: expected stack trace of error %+v to have a frame containing %s, but it doesn't. Captured frames:\n%s", actual.Value(), frameSubstring, strings.Join(frames, "\n"))
+}
+
+func shouldBeAnagramOf(actual types.Assertable, other string, actualRunes, otherRunes []rune) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be an anagram of %s, but it's not. Sorted characters are %q and %q", actual.Value(), other, string(actualRunes), string(otherRunes))
+}
+
+func shouldBeEqualIgnoringNilValues(actual types.Assertable, expected interface{}) string {
+	differences := strings.Builder{}
+	actualValue, expectedValue := reflect.ValueOf(actual.Value()), reflect.ValueOf(expected)
+	iter := expectedValue.MapRange()
+	for iter.Next() {
+		actualEntry := actualValue.MapIndex(iter.Key())
+		switch {
+		case !actualEntry.IsValid():
+			differences.WriteString(fmt.Sprintf("key [%+v]: missing, expected %+v\n", iter.Key(), iter.Value()))
+		case !reflect.DeepEqual(actualEntry.Interface(), iter.Value().Interface()):
+			differences.WriteString(fmt.Sprintf("key [%+v]: %+v, expected %+v\n", iter.Key(), actualEntry, iter.Value()))
+		}
+	}
+	iter = actualValue.MapRange()
+	for iter.Next() {
+		if !expectedValue.MapIndex(iter.Key()).IsValid() {
+			differences.WriteString(fmt.Sprintf("key [%+v]: %+v, expected to be absent\n", iter.Key(), iter.Value()))
+		}
+	}
+
+	return fmt.Sprintf("assertion failed: expected maps to be equal ignoring nil and zero values\nexpected value\t:%+v\nactual value\t:%+v\n%s", expected, actual.Value(), differences.String())
+}
+
+func shouldBeValidXML(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be valid XML, but it's not: %s", actual.Value(), err)
+}
+
+func shouldBeValidJSON(actual types.Assertable, err error) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be valid JSON, but it's not: %s", actual.Value(), err)
+}
+
+func shouldBeJSONEqual(expected, actual string) string {
+	return fmt.Sprintf("assertion failed: expected JSON documents to be equal\nexpected normalized value\t:%s\nactual normalized value\t:%s", expected, actual)
+}
+
+func shouldBeXMLEqual(expected, actual string) string {
+	return fmt.Sprintf("assertion failed: expected XML documents to be equal\nexpected canonical value\t:%s\nactual canonical value\t:%s", expected, actual)
+}
+
+func shouldHaveUniqueKeys(actual types.Assertable, duplicateKey interface{}) string {
+	return fmt.Sprintf("assertion failed: expected elements of %+v to have unique keys, but key %+v is duplicate", actual.Value(), duplicateKey)
+}
+
+func shouldHaveComparableKeys(actual types.Assertable, key interface{}) string {
+	return fmt.Sprintf("assertion failed: expected elements of %+v to have comparable keys, but key %+v of type %T is not comparable", actual.Value(), key, key)
+}
+
+func shouldHaveMinEntropyBits(actual types.Assertable, bits, entropy float64) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have at least %.2f bits of entropy, but it has an estimated %.2f", actual.Value(), bits, entropy)
+}
+
+func shouldHaveSameLengthSecret(actualLength, expectedLength int) string {
+	return fmt.Sprintf("assertion failed: expected a value of %d bytes, but it has %d bytes", expectedLength, actualLength)
+}
+
+func shouldBeConstantTimeEqual() string {
+	return "assertion failed: expected values to be equal in constant time comparison, but they're not"
+}
+
+func shouldBeEqualWithin(actual types.Assertable, expected, epsilon float64) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be equal to %+v within a tolerance of %+v, but they differ by %+v", actual.Value(), expected, epsilon, math.Abs(actual.Value().(float64)-expected))
+}
+
+func shouldBeNaN(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be NaN", actual.Value())
+}
+
+func shouldBeInf(actual types.Assertable, sign int) string {
+	infinity := "an infinity"
+	switch {
+	case sign > 0:
+		infinity = "positive infinity"
+	case sign < 0:
+		infinity = "negative infinity"
+	}
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be %s", actual.Value(), infinity)
+}
+
+func shouldHaveVerboseFormatContaining(substring, verbose string) string {
+	return fmt.Sprintf("assertion failed: expected verbose format of error to contain %s, but it doesn't. Verbose format:\n%s", substring, verbose)
+}
+
+func shouldBeAtTimeOfDay(actual types.Assertable, expected string, local time.Time) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be at %s, but its clock time is %s", actual.Value(), expected, local.Format("15:04:05.999999999 MST"))
+}
+
+func shouldNotBeBefore(actual types.Assertable, expected time.Time) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, not to be before %+v", actual.Value(), expected)
+}
+
+func shouldNotBeAfter(actual types.Assertable, expected time.Time) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, not to be after %+v", actual.Value(), expected)
+}
+
+func shouldBeValidMarkdownLink(link, reason string) string {
+	return fmt.Sprintf("assertion failed: expected markdown link %s to be valid, but %s", link, reason)
+}
+
+func shouldBeBetween(actual types.Assertable, start, end interface{}) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be between %+v and %+v", actual.Value(), start, end)
+}
+
+func shouldHaveValidRange(min, max interface{}) string {
+	return fmt.Sprintf("assertion failed: expected a valid range, but min %+v is greater than max %+v", min, max)
+}
+
+func shouldBeWithinDuration(actual types.Assertable, expected time.Time, delta, difference time.Duration) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be within %s of %+v, but the difference is %s", actual.Value(), delta, expected, difference)
+}
+
+func shouldBeRedacted(actual types.Assertable, pattern, match string) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be redacted, but [%s] matches sensitive pattern [%s]", actual.Value(), match, pattern)
+}
+
+func shouldBeIntSlice(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: assertable should be a slice of integers but it is %T", actual.Value())
+}
+
+func shouldHaveNoGaps(actual types.Assertable, missing, next int64) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have no gaps, but %d is missing before %d", actual.Value(), missing, next)
+}
+
+func shouldHaveNextInSequence(actual types.Assertable, expected, previous, found int64, index int) string {
+	return fmt.Sprintf("assertion failed: expected %d after %d in %+v, but found %d at index %d", expected, previous, actual.Value(), found, index)
+}
+
+func shouldHaveSameLengthAsMask(actual types.Assertable, mask string, maskLength, actualLength int) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have the length [%d] of mask [%s], but it has length [%d]", actual.Value(), maskLength, mask, actualLength)
+}
+
+func shouldMatchMask(actual types.Assertable, mask string, position int, expected, found rune) string {
+	return fmt.Sprintf("assertion failed: expected %+v to match mask [%s], but at position [%d] expected %q and found %q", actual.Value(), mask, position, expected, found)
+}
+
+func shouldBeClassifiable(actual types.Assertable, classification, method string) string {
+	return fmt.Sprintf("assertion failed: expected error %+v to be classified as %s, but it can't be classified: neither it nor any error in its chain exposes a %s method", actual.Value(), classification, method)
+}
+
+func shouldBeClassifiedAs(actual types.Assertable, classification, method string) string {
+	return fmt.Sprintf("assertion failed: expected error %+v to be classified as %s, but its %s method returns false", actual.Value(), classification, method)
+}
+
+func shouldHaveRunLengthEncoding(actual types.Assertable, expected, actualRuns []Run, index int) string {
+	return fmt.Sprintf("assertion failed: expected run-length encoding of %+v to be %+v, but it is %+v and differs at run [%d]", actual.Value(), expected, actualRuns, index)
+}
+
+func shouldBeErrorWithMessage(relation, message string) string {
+	return fmt.Sprintf("assertion failed: expected an error with message %s [%s], but the error is nil", relation, message)
+}
+
+func shouldHaveErrorMessage(actual types.Assertable, relation, message string) string {
+	return fmt.Sprintf("assertion failed: expected error message %s [%s], but it is [%+v]", relation, message, actual.Value())
+}
+
+func shouldBeErrorInChain(actual types.Assertable, target error) string {
+	return fmt.Sprintf("assertion failed: expected error [%+v] or an error in its chain to match [%+v], but none does", actual.Value(), target)
+}
+
+func shouldBeValidErrorTarget(target interface{}) string {
+	return fmt.Sprintf("assertion failed: expected target to be a non-nil pointer to an error or interface type, but it is %T", target)
+}
+
+func shouldBeErrorAs(actual types.Assertable, targetType reflect.Type) string {
+	return fmt.Sprintf("assertion failed: expected error [%+v] or an error in its chain to be of type %s, but none is", actual.Value(), targetType)
+}
+
+func shouldPanic() string {
+	return "assertion failed: expected function to panic, but it returned normally"
+}
+
+func shouldNotPanic(recovered interface{}) string {
+	return fmt.Sprintf("assertion failed: expected function not to panic, but it panicked with [%+v]", recovered)
+}
+
+func shouldPanicWith(expected interface{}) string {
+	return fmt.Sprintf("assertion failed: expected function to panic with [%+v], but it returned normally", expected)
+}
+
+func shouldHavePanicValue(recovered, expected interface{}) string {
+	return fmt.Sprintf("assertion failed: expected function to panic with [%+v] (%T), but it panicked with [%+v] (%T)", expected, expected, recovered, recovered)
+}
+
+func shouldBeOneOf(actual types.Assertable, candidates interface{}) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be one of %+v, but it's not", actual.Value(), candidates)
+}
+
+func shouldBeNoneOf(actual types.Assertable, candidates, match interface{}) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be none of %+v, but it's equal to %+v", actual.Value(), candidates, match)
+}
+
+func shouldContainExactly(actual types.Assertable, elements interface{}, order string, missing, extra []interface{}) string {
+	return fmt.Sprintf("assertion failed: expected %+v to contain exactly %+v %s, but it doesn't\nmissing elements\t:%+v\nextra elements\t:%+v", actual.Value(), elements, order, missing, extra)
+}
+
+func shouldContainExactlyInOrder(actual types.Assertable, elements interface{}, index int, expected, found interface{}) string {
+	return fmt.Sprintf("assertion failed: expected %+v to contain exactly %+v in the same order, but element at index %d is %+v instead of %+v", actual.Value(), elements, index, found, expected)
+}
+
+func shouldBeSortable(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected a less function to sort %T elements that have no natural order", actual.Value())
+}
+
+func shouldBeSorted(actual types.Assertable, order string, index int) string {
+	return fmt.Sprintf("assertion failed: expected %+v to be sorted in %s order, but the order breaks at index [%d]", actual.Value(), order, index)
+}
+
+func shouldBeReceivableChannel(actual types.Assertable) string {
+	return fmt.Sprintf("assertion failed: expected a channel to receive from, but it is %T", actual.Value())
+}
+
+func shouldReceiveWithin(d time.Duration) string {
+	return fmt.Sprintf("assertion failed: expected to receive a value from the channel, but none arrived within %s", d)
+}
+
+func shouldReceiveFromOpenChannel() string {
+	return "assertion failed: expected to receive a value from the channel, but it is closed"
+}
+
+func shouldReceiveValue(expected, received interface{}) string {
+	return fmt.Sprintf("assertion failed: expected to receive [%+v] from the channel, but received [%+v]", expected, received)
+}
+
+func shouldBeClosedChannel() string {
+	return "assertion failed: expected the channel to be closed, but it is open"
+}
+
+func shouldBeDrainedChannel(value interface{}) string {
+	return fmt.Sprintf("assertion failed: expected the channel to be closed, but it has value [%+v] ready", value)
+}
+
+func shouldBeRelativeToNow(actual types.Assertable, relation string, now time.Time) string {
+	return fmt.Sprintf("assertion failed: expected value of = %+v, to be %s, but now is %+v", actual.Value(), relation, now)
+}
+
+func shouldHaveSameCalendarValue(actual types.Assertable, field string, expected time.Time, actualValue, expectedValue string, location *time.Location) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have the same %s as %+v, but they are %s and %s in %s", actual.Value(), field, expected, actualValue, expectedValue, location)
+}
+
+func shouldHaveField(actual types.Assertable, name string) string {
+	return fmt.Sprintf("assertion failed: expected %+v to have an exported field named %s, but it doesn't", actual.Value(), name)
+}
+
+func shouldHaveFieldWithValue(actual types.Assertable, name string, expected, found interface{}) string {
+	return fmt.Sprintf("assertion failed: expected field %s of %+v to be %+v, but it is %+v", name, actual.Value(), expected, found)
+}
+
+func shouldBeEqualIgnoringFields(actual types.Assertable, expected interface{}, fields []string, field string) string {
+	if field == "" {
+		return fmt.Sprintf("assertion failed: expected %+v to be a struct of the same type as %+v, but it isn't", actual.Value(), expected)
+	}
+	return fmt.Sprintf("assertion failed: expected %+v to be equal to %+v ignoring fields %v, but field %s is different", actual.Value(), expected, fields, field)
+}
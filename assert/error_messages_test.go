@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
@@ -60,6 +61,42 @@ func Test_shouldBeEqual(t *testing.T) {
 			expected:        "i'm a simple type but not equal",
 			expectedMessage: "assertion failed:\nexpected value\t:i'm a simple type but not equal\nactual value\t:i'm a simple type\n",
 		},
+		{
+			name:     "should return string diff when multi-line strings are not equal",
+			actual:   values.NewStringValue("first line\nsecond line\nthird line"),
+			expected: "first line\nsecond lime\nthird line",
+			expectedMessage: "assertion failed:\n" +
+				"expected value\t:first line\nsecond lime\nthird line\n" +
+				"actual value\t:first line\nsecond line\nthird line\n" +
+				"first difference at index 20 (line 2, column 10):\n" +
+				"expected: second lime\n" +
+				"actual:   second line\n" +
+				"                   ^\n",
+		},
+		{
+			name:     "should return string diff when long strings are not equal",
+			actual:   values.NewStringValue("the quick brown fox jumps over the lazy dog and keeps running far away"),
+			expected: "the quick brown fox jumps over the lazy cat and keeps running far away",
+			expectedMessage: "assertion failed:\n" +
+				"expected value\t:the quick brown fox jumps over the lazy cat and keeps running far away\n" +
+				"actual value\t:the quick brown fox jumps over the lazy dog and keeps running far away\n" +
+				"first difference at index 40 (line 1, column 41):\n" +
+				"expected: ...jumps over the lazy cat and keeps runnin...\n" +
+				"actual:   ...jumps over the lazy dog and keeps runnin...\n" +
+				"                                 ^\n",
+		},
+		{
+			name:     "should return string diff when a multi-line string is a prefix of the other",
+			actual:   values.NewStringValue("line one\nline two"),
+			expected: "line one\nline two\n",
+			expectedMessage: "assertion failed:\n" +
+				"expected value\t:line one\nline two\n\n" +
+				"actual value\t:line one\nline two\n" +
+				"first difference at index 17 (line 2, column 9):\n" +
+				"expected: line two\n" +
+				"actual:   line two\n" +
+				"                  ^\n",
+		},
 		{
 			name: "should return expected message when structs are not equal",
 			actual: values.NewStructValue(assertedStruct{
@@ -88,3 +125,65 @@ func Test_shouldBeEqual(t *testing.T) {
 		})
 	}
 }
+
+func Test_shouldBeEqual_DecoratedStringDiff(t *testing.T) {
+	value := strings.Repeat("abcdefghij", 8)
+	actual := values.NewStringValue(strings.ToUpper(value) + "X").AddDecorator(strings.ToLower)
+	That(t, shouldBeEqual(actual, value+"Y")).IsEqualTo("assertion failed:\n" +
+		"expected value\t:" + value + "Y\n" +
+		"actual value\t:" + strings.ToUpper(value) + "X\n" +
+		"first difference at index 80 (line 1, column 81):\n" +
+		"expected: ...abcdefghijabcdefghijy\n" +
+		"actual:   ...abcdefghijabcdefghijx\n" +
+		"                                 ^\n")
+}
+
+func Test_shouldBeDeepEqual(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+
+	tests := []struct {
+		name            string
+		actual          types.Assertable
+		expected        interface{}
+		expectedMessage string
+	}{
+		{
+			name:     "should report changed element fields",
+			actual:   values.NewSliceValue([]person{{Name: "a", Age: 1}, {Name: "b", Age: 2}, {Name: "b", Age: 3}}),
+			expected: []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}, {Name: "a", Age: 3}},
+			expectedMessage: "assertion failed:\n" +
+				"expected value\t:[{Name:a Age:1} {Name:b Age:2} {Name:a Age:3}]\n" +
+				"actual value\t:[{Name:a Age:1} {Name:b Age:2} {Name:b Age:3}]\n" +
+				"index [2].Name: \"a\" -> \"b\"\n",
+		},
+		{
+			name:     "should report added element fields",
+			actual:   values.NewSliceValue([]person{{Name: "a", Age: 1}, {Name: "b", Age: 2}}),
+			expected: []person{{Name: "a", Age: 1}},
+			expectedMessage: "assertion failed:\n" +
+				"expected value\t:[{Name:a Age:1}]\n" +
+				"actual value\t:[{Name:a Age:1} {Name:b Age:2}]\n" +
+				"index [1].Name: <nil> -> \"b\"\n" +
+				"index [1].Age: <nil> -> 2\n",
+		},
+		{
+			name:     "should report removed element fields",
+			actual:   values.NewSliceValue([]person{{Name: "a", Age: 1}}),
+			expected: []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			expectedMessage: "assertion failed:\n" +
+				"expected value\t:[{Name:a Age:1} {Name:b Age:2}]\n" +
+				"actual value\t:[{Name:a Age:1}]\n" +
+				"index [1].Name: \"b\" -> <nil>\n" +
+				"index [1].Age: 2 -> <nil>\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualMessage := shouldBeDeepEqual(tt.actual, tt.expected)
+			That(t, actualMessage).IsEqualTo(tt.expectedMessage)
+		})
+	}
+}
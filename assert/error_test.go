@@ -2,6 +2,8 @@ package assert
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"testing"
 )
 
@@ -31,6 +33,135 @@ func TestAssertableError_IsNil(t *testing.T) {
 	}
 }
 
+func TestAssertableError_HasMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		message    string
+		shouldFail bool
+	}{
+		{
+			name:    "should assert error message",
+			actual:  errors.New("not found"),
+			message: "not found",
+		},
+		{
+			name:    "should assert wrapped error message",
+			actual:  fmt.Errorf("loading user: %w", errors.New("not found")),
+			message: "loading user: not found",
+		},
+		{
+			name:       "should fail for message of the wrapped error only",
+			actual:     fmt.Errorf("loading user: %w", errors.New("not found")),
+			message:    "not found",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			message:    "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).HasMessage(tt.message)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableError_HasMessageContaining(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		substr     string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert message of the wrapped error",
+			actual: fmt.Errorf("loading user: %w", errors.New("not found")),
+			substr: "not found",
+		},
+		{
+			name:   "should assert empty substring",
+			actual: errors.New("not found"),
+			substr: "",
+		},
+		{
+			name:       "should fail for message not containing substring",
+			actual:     fmt.Errorf("loading user: %w", errors.New("not found")),
+			substr:     "timeout",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			substr:     "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).HasMessageContaining(tt.substr)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableError_Is(t *testing.T) {
+	errNotFound := errors.New("not found")
+	tests := []struct {
+		name       string
+		actual     error
+		target     error
+		shouldFail bool
+	}{
+		{
+			name:   "should assert same error",
+			actual: errNotFound,
+			target: errNotFound,
+		},
+		{
+			name:   "should assert wrapped error",
+			actual: fmt.Errorf("handler: %w", fmt.Errorf("loading user: %w", errNotFound)),
+			target: errNotFound,
+		},
+		{
+			name:   "should assert nil error with nil target",
+			actual: nil,
+			target: nil,
+		},
+		{
+			name:       "should fail for error with the same message",
+			actual:     errors.New("not found"),
+			target:     errNotFound,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for error formatted without wrapping",
+			actual:     fmt.Errorf("loading user: %v", errNotFound),
+			target:     errNotFound,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			target:     errNotFound,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).Is(tt.target)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
 func TestAssertableError_IsNotNil(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -139,3 +270,439 @@ func TestAssertableError_IsSameAs(t *testing.T) {
 		})
 	}
 }
+
+type customError struct {
+	code int
+}
+
+func (e customError) Error() string {
+	return fmt.Sprintf("custom error with code %d", e.code)
+}
+
+func TestAssertableError_IsOfType(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		target     error
+		shouldFail bool
+	}{
+		{
+			name:   "should assert same concrete error type",
+			actual: customError{code: 1},
+			target: customError{},
+		},
+		{
+			name:   "should assert same pointer error type regardless of the value",
+			actual: &os.PathError{Op: "open"},
+			target: &os.PathError{},
+		},
+		{
+			name:       "should fail for different error types",
+			actual:     errors.New("some error"),
+			target:     customError{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for wrapped error of the target type",
+			actual:     fmt.Errorf("wrapped: %w", customError{code: 1}),
+			target:     customError{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			target:     customError{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).IsOfType(tt.target)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+type joinedError []error
+
+func (e joinedError) Error() string {
+	return fmt.Sprintf("%d errors occurred", len(e))
+}
+
+func (e joinedError) Unwrap() []error {
+	return e
+}
+
+func TestAssertableError_HasJoinedErrorCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		count      int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert number of joined errors",
+			actual: joinedError{errors.New("first"), errors.New("second")},
+			count:  2,
+		},
+		{
+			name:   "should assert joined error wrapped in an aggregation",
+			actual: joinedError{errors.New("first"), joinedError{errors.New("second"), errors.New("third")}},
+			count:  2,
+		},
+		{
+			name:   "should assert zero joined errors for plain error",
+			actual: errors.New("plain"),
+			count:  0,
+		},
+		{
+			name:       "should fail for different number of joined errors",
+			actual:     joinedError{errors.New("first"), errors.New("second")},
+			count:      3,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			count:      0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).HasJoinedErrorCount(tt.count)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableError_JoinedErrorsContain(t *testing.T) {
+	errNotFound := errors.New("not found")
+	tests := []struct {
+		name       string
+		actual     error
+		target     error
+		shouldFail bool
+	}{
+		{
+			name:   "should assert joined error",
+			actual: joinedError{errors.New("first"), errNotFound},
+			target: errNotFound,
+		},
+		{
+			name:   "should assert wrapped joined error",
+			actual: joinedError{errors.New("first"), fmt.Errorf("lookup: %w", errNotFound)},
+			target: errNotFound,
+		},
+		{
+			name:       "should fail if no joined error matches",
+			actual:     joinedError{errors.New("first"), errors.New("not found")},
+			target:     errNotFound,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for plain error",
+			actual:     errNotFound,
+			target:     errNotFound,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			target:     errNotFound,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).JoinedErrorsContain(tt.target)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+type tracedError struct {
+	frames []string
+}
+
+func (e tracedError) Error() string {
+	return "traced error"
+}
+
+func (e tracedError) StackTrace() []string {
+	return e.frames
+}
+
+func TestAssertableError_StackTraceContains(t *testing.T) {
+	traced := tracedError{frames: []string{
+		"github.com/acme/app/store.Load\n\t/app/store/store.go:42",
+		"main.main\n\t/app/main.go:10",
+	}}
+	tests := []struct {
+		name           string
+		actual         error
+		frameSubstring string
+		shouldFail     bool
+	}{
+		{
+			name:           "should assert frame containing the substring",
+			actual:         traced,
+			frameSubstring: "store.Load",
+		},
+		{
+			name:           "should assert frame of wrapped traced error",
+			actual:         fmt.Errorf("loading: %w", traced),
+			frameSubstring: "store.go:42",
+		},
+		{
+			name:           "should fail if no frame contains the substring",
+			actual:         traced,
+			frameSubstring: "handler.Serve",
+			shouldFail:     true,
+		},
+		{
+			name:           "should fail if error doesn't carry a stack trace",
+			actual:         errors.New("plain"),
+			frameSubstring: "main.main",
+			shouldFail:     true,
+		},
+		{
+			name:           "should fail for nil error",
+			actual:         nil,
+			frameSubstring: "main.main",
+			shouldFail:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).StackTraceContains(tt.frameSubstring)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+type verboseError struct{}
+
+func (e verboseError) Error() string {
+	return "verbose error"
+}
+
+func (e verboseError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		_, _ = fmt.Fprint(s, "verbose error\nat main.go:10")
+		return
+	}
+	_, _ = fmt.Fprint(s, e.Error())
+}
+
+func TestAssertableError_VerboseFormatContains(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		substring  string
+		shouldFail bool
+	}{
+		{
+			name:      "should assert verbose only context",
+			actual:    verboseError{},
+			substring: "main.go:10",
+		},
+		{
+			name:      "should assert message of plain error",
+			actual:    errors.New("plain error"),
+			substring: "plain",
+		},
+		{
+			name:       "should fail if verbose format doesn't contain substring",
+			actual:     verboseError{},
+			substring:  "handler.go",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			substring:  "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).VerboseFormatContains(tt.substring)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+type networkError struct {
+	timeout   bool
+	temporary bool
+}
+
+func (e networkError) Error() string {
+	return "network error"
+}
+
+func (e networkError) Timeout() bool {
+	return e.timeout
+}
+
+func (e networkError) Temporary() bool {
+	return e.temporary
+}
+
+func TestAssertableError_IsTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		shouldFail bool
+	}{
+		{
+			name:   "should assert timeout error",
+			actual: networkError{timeout: true},
+		},
+		{
+			name:   "should assert wrapped timeout error",
+			actual: fmt.Errorf("dialing: %w", networkError{timeout: true}),
+		},
+		{
+			name:   "should assert os deadline exceeded error",
+			actual: os.ErrDeadlineExceeded,
+		},
+		{
+			name:       "should fail for not timeout error",
+			actual:     networkError{temporary: true},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for not classifiable error",
+			actual:     errors.New("plain error"),
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).IsTimeout()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableError_IsTemporary(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     error
+		shouldFail bool
+	}{
+		{
+			name:   "should assert temporary error",
+			actual: networkError{temporary: true},
+		},
+		{
+			name:   "should assert wrapped temporary error",
+			actual: fmt.Errorf("reading: %w", networkError{temporary: true}),
+		},
+		{
+			name:       "should fail for not temporary error",
+			actual:     networkError{timeout: true},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for not classifiable error",
+			actual:     errors.New("plain error"),
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).IsTemporary()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+type validationError struct {
+	field string
+}
+
+func (e *validationError) Error() string {
+	return "invalid " + e.field
+}
+
+func TestAssertableError_As(t *testing.T) {
+	wrappedTwice := fmt.Errorf("handler: %w", fmt.Errorf("decoding: %w", &validationError{field: "email"}))
+
+	t.Run("should populate target from error wrapped twice", func(t *testing.T) {
+		test := &testing.T{}
+		var target *validationError
+		ThatError(test, wrappedTwice).As(&target)
+		ThatBool(t, test.Failed()).IsFalse()
+		ThatString(t, target.field).IsEqualTo("email")
+	})
+
+	tests := []struct {
+		name       string
+		actual     error
+		target     interface{}
+		shouldFail bool
+	}{
+		{
+			name:   "should assert interface target",
+			actual: fmt.Errorf("dialing: %w", networkError{timeout: true}),
+			target: new(interface{ Timeout() bool }),
+		},
+		{
+			name:       "should fail if no error in the chain has the target type",
+			actual:     fmt.Errorf("handler: %w", errors.New("plain error")),
+			target:     new(*validationError),
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil error",
+			actual:     nil,
+			target:     new(*validationError),
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for non-pointer target",
+			actual:     wrappedTwice,
+			target:     validationError{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for nil target",
+			actual:     wrappedTwice,
+			target:     nil,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for pointer to non-error type",
+			actual:     wrappedTwice,
+			target:     new(string),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatError(test, tt.actual).As(tt.target)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
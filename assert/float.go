@@ -0,0 +1,124 @@
+package assert
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
+)
+
+// AssertableFloat64 is the assertable structure for float64 values.
+type AssertableFloat64 struct {
+	assertion
+	actual  values.FloatValue
+	epsilon float64
+}
+
+// FloatOpt is a configuration option to initialize an AssertableFloat64.
+type FloatOpt func(*AssertableFloat64)
+
+// WithEpsilon sets the default tolerance used by IsEqualTo and IsNotEqualTo, so values that differ by at most epsilon
+// are considered equal. By default values are compared exactly.
+func WithEpsilon(epsilon float64) FloatOpt {
+	return func(c *AssertableFloat64) {
+		c.epsilon = epsilon
+	}
+}
+
+// ThatFloat64 returns an AssertableFloat64 structure initialized with the test reference and the actual value to assert.
+func ThatFloat64(t *testing.T, actual float64, opts ...FloatOpt) AssertableFloat64 {
+	t.Helper()
+	return thatFloat64(assertion{t: t}, actual, opts...)
+}
+
+func thatFloat64(a assertion, actual float64, opts ...FloatOpt) AssertableFloat64 {
+	assertable := &AssertableFloat64{
+		assertion: a,
+		actual:    values.NewFloatValue(actual),
+	}
+	for _, opt := range opts {
+		opt(assertable)
+	}
+	return *assertable
+}
+
+// IsEqualTo asserts if the expected float64 is equal to the assertable float64 value within the default tolerance set
+// by WithEpsilon, if any.
+// It errors the tests if the compared values (actual VS expected) are not equal.
+func (a AssertableFloat64) IsEqualTo(expected float64) AssertableFloat64 {
+	if !a.actual.IsEqualToWithin(expected, a.epsilon) {
+		a.error(shouldBeEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsNotEqualTo asserts if the expected float64 is not equal to the assertable float64 value within the default
+// tolerance set by WithEpsilon, if any.
+// It errors the tests if the compared values (actual VS expected) are equal.
+func (a AssertableFloat64) IsNotEqualTo(expected float64) AssertableFloat64 {
+	if a.actual.IsEqualToWithin(expected, a.epsilon) {
+		a.error(shouldNotBeEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsEqualToWithin asserts if the assertable float64 value differs from the expected value by at most epsilon
+// It errors the tests if the absolute difference of the compared values (actual VS expected) is greater than epsilon.
+func (a AssertableFloat64) IsEqualToWithin(expected, epsilon float64) AssertableFloat64 {
+	if !a.actual.IsEqualToWithin(expected, epsilon) {
+		a.error(shouldBeEqualWithin(a.actual, expected, epsilon))
+	}
+	return a
+}
+
+// IsGreaterThan asserts if the assertable float64 value is greater than the expected value
+// It errors the tests if is not greater.
+func (a AssertableFloat64) IsGreaterThan(expected float64) AssertableFloat64 {
+	if !a.actual.IsGreaterThan(expected) {
+		a.error(shouldBeGreater(a.actual, expected))
+	}
+	return a
+}
+
+// IsLessThan asserts if the assertable float64 value is less than the expected value
+// It errors the tests if is not less.
+func (a AssertableFloat64) IsLessThan(expected float64) AssertableFloat64 {
+	if !a.actual.IsLessThan(expected) {
+		a.error(shouldBeLessThan(a.actual, expected))
+	}
+	return a
+}
+
+// IsNaN asserts if the assertable float64 value is NaN
+// It errors the tests if the value is a number.
+func (a AssertableFloat64) IsNaN() AssertableFloat64 {
+	if !math.IsNaN(a.actual.Value().(float64)) {
+		a.error(shouldBeNaN(a.actual))
+	}
+	return a
+}
+
+// IsInf asserts if the assertable float64 value is an infinity according to the sign as math.IsInf does:
+// * positive infinity if sign > 0
+// * negative infinity if sign < 0
+// * either infinity if sign == 0.
+// It errors the tests if the value is not such an infinity.
+func (a AssertableFloat64) IsInf(sign int) AssertableFloat64 {
+	if !math.IsInf(a.actual.Value().(float64), sign) {
+		a.error(shouldBeInf(a.actual, sign))
+	}
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableFloat64) WithMessage(message string) AssertableFloat64 {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableFloat64) AsRequirement() AssertableFloat64 {
+	a.requirement = true
+	return a
+}
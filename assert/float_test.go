@@ -0,0 +1,293 @@
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAssertableFloat64_IsEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		expected   float64
+		shouldFail bool
+	}{
+		{
+			name:       "should assert not equal floats",
+			actual:     -10.5,
+			expected:   10.5,
+			shouldFail: true,
+		},
+		{
+			name:       "should assert equal floats",
+			actual:     -10.5,
+			expected:   -10.5,
+			shouldFail: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			NewFluentT(test).AssertThatFloat64(tt.actual).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_IsNotEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		expected   float64
+		shouldFail bool
+	}{
+		{
+			name:       "should assert not equal floats",
+			actual:     -10.5,
+			expected:   10.5,
+			shouldFail: false,
+		},
+		{
+			name:       "should assert equal floats",
+			actual:     -10.5,
+			expected:   -10.5,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			NewFluentT(test).AssertThatFloat64(tt.actual).IsNotEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_WithEpsilon(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		expected   float64
+		floatOpts  []FloatOpt
+		shouldFail bool
+	}{
+		{
+			name:      "should assert equal floats within default epsilon",
+			actual:    0.30000000000000004,
+			expected:  0.3,
+			floatOpts: []FloatOpt{WithEpsilon(1e-9)},
+		},
+		{
+			name:       "should assert floats exactly without default epsilon",
+			actual:     0.30000000000000004,
+			expected:   0.3,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for floats differing more than default epsilon",
+			actual:     0.31,
+			expected:   0.3,
+			floatOpts:  []FloatOpt{WithEpsilon(1e-9)},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatFloat64(test, tt.actual, tt.floatOpts...).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_IsEqualToWithin(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		expected   float64
+		epsilon    float64
+		shouldFail bool
+	}{
+		{
+			name:     "should assert floats within epsilon",
+			actual:   0.30000000000000004,
+			expected: 0.3,
+			epsilon:  1e-9,
+		},
+		{
+			name:     "should assert floats differing exactly by epsilon",
+			actual:   -1.5,
+			expected: -1,
+			epsilon:  0.5,
+		},
+		{
+			name:     "should assert equal infinities",
+			actual:   math.Inf(1),
+			expected: math.Inf(1),
+			epsilon:  0,
+		},
+		{
+			name:       "should fail for floats differing more than epsilon",
+			actual:     1.2,
+			expected:   1,
+			epsilon:    0.1,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for NaN",
+			actual:     math.NaN(),
+			expected:   math.NaN(),
+			epsilon:    1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatFloat64(test, tt.actual).IsEqualToWithin(tt.expected, tt.epsilon)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_IsGreaterThan(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		expected   float64
+		shouldFail bool
+	}{
+		{
+			name:     "should assert greater float",
+			actual:   -1.5,
+			expected: -2.5,
+		},
+		{
+			name:       "should fail for equal floats",
+			actual:     1.5,
+			expected:   1.5,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for less float",
+			actual:     1.4,
+			expected:   1.5,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatFloat64(test, tt.actual).IsGreaterThan(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_IsLessThan(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		expected   float64
+		shouldFail bool
+	}{
+		{
+			name:     "should assert less float",
+			actual:   -2.5,
+			expected: -1.5,
+		},
+		{
+			name:       "should fail for equal floats",
+			actual:     1.5,
+			expected:   1.5,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for greater float",
+			actual:     1.6,
+			expected:   1.5,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatFloat64(test, tt.actual).IsLessThan(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_IsNaN(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		shouldFail bool
+	}{
+		{
+			name:   "should assert NaN",
+			actual: math.NaN(),
+		},
+		{
+			name:       "should fail for a number",
+			actual:     1,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for infinity",
+			actual:     math.Inf(1),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatFloat64(test, tt.actual).IsNaN()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableFloat64_IsInf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     float64
+		sign       int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert positive infinity",
+			actual: math.Inf(1),
+			sign:   1,
+		},
+		{
+			name:   "should assert negative infinity",
+			actual: math.Inf(-1),
+			sign:   -1,
+		},
+		{
+			name:   "should assert any infinity",
+			actual: math.Inf(-1),
+			sign:   0,
+		},
+		{
+			name:       "should fail for infinity of other sign",
+			actual:     math.Inf(1),
+			sign:       -1,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a number",
+			actual:     math.MaxFloat64,
+			sign:       0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatFloat64(test, tt.actual).IsInf(tt.sign)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
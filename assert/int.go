@@ -8,16 +8,20 @@ import (
 
 // AssertableInt is the assertable structure for int values.
 type AssertableInt struct {
-	t      *testing.T
+	assertion
 	actual values.IntValue
 }
 
 // ThatInt returns an AssertableInt structure initialized with the test reference and the actual value to assert.
 func ThatInt(t *testing.T, actual int) AssertableInt {
 	t.Helper()
+	return thatInt(assertion{t: t}, actual)
+}
+
+func thatInt(a assertion, actual int) AssertableInt {
 	return AssertableInt{
-		t:      t,
-		actual: values.NewIntValue(actual),
+		assertion: a,
+		actual:    values.NewIntValue(actual),
 	}
 }
 
@@ -25,7 +29,7 @@ func ThatInt(t *testing.T, actual int) AssertableInt {
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableInt) IsEqualTo(expected int) AssertableInt {
 	if !a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -34,7 +38,7 @@ func (a AssertableInt) IsEqualTo(expected int) AssertableInt {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableInt) IsNotEqualTo(expected int) AssertableInt {
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -43,7 +47,7 @@ func (a AssertableInt) IsNotEqualTo(expected int) AssertableInt {
 // It errors the tests if is not greater.
 func (a AssertableInt) IsGreaterThan(expected int) AssertableInt {
 	if !a.actual.IsGreaterThan(expected) {
-		a.t.Error(shouldBeGreater(a.actual, expected))
+		a.error(shouldBeGreater(a.actual, expected))
 	}
 	return a
 }
@@ -52,7 +56,7 @@ func (a AssertableInt) IsGreaterThan(expected int) AssertableInt {
 // It errors the tests if is not greater.
 func (a AssertableInt) IsGreaterThanOrEqualTo(expected int) AssertableInt {
 	if !a.actual.IsGreaterOrEqualTo(expected) {
-		a.t.Error(shouldBeGreaterOrEqual(a.actual, expected))
+		a.error(shouldBeGreaterOrEqual(a.actual, expected))
 	}
 	return a
 }
@@ -61,7 +65,7 @@ func (a AssertableInt) IsGreaterThanOrEqualTo(expected int) AssertableInt {
 // It errors the tests if is not greater.
 func (a AssertableInt) IsLessThan(expected int) AssertableInt {
 	if !a.actual.IsLessThan(expected) {
-		a.t.Error(shouldBeLessThan(a.actual, expected))
+		a.error(shouldBeLessThan(a.actual, expected))
 	}
 	return a
 }
@@ -70,8 +74,113 @@ func (a AssertableInt) IsLessThan(expected int) AssertableInt {
 // It errors the tests if is not greater.
 func (a AssertableInt) IsLessThanOrEqualTo(expected int) AssertableInt {
 	if !a.actual.IsLessOrEqualTo(expected) {
-		a.t.Error(shouldBeLessOrEqual(a.actual, expected))
+		a.error(shouldBeLessOrEqual(a.actual, expected))
+	}
+
+	return a
+}
+
+// IsZero asserts if the assertable int value is zero
+// It errors the tests if the value is not zero.
+func (a AssertableInt) IsZero() AssertableInt {
+	if !a.actual.IsEqualTo(0) {
+		a.error(shouldBeEqual(a.actual, 0))
+	}
+	return a
+}
+
+// HasDigitCount asserts if the assertable int value has the expected number of decimal digits
+// The sign of negative values is not counted and zero has one digit.
+// It errors the tests if the value has a different number of digits.
+func (a AssertableInt) HasDigitCount(expected int) AssertableInt {
+	if a.actual.DigitCount() != expected {
+		a.error(shouldHaveDigitCount(a.actual, expected))
 	}
+	return a
+}
+
+// IsInDigitRange asserts if the number of decimal digits of the assertable int value is between the given min and max
+// (inclusive). The sign of negative values is not counted and zero has one digit.
+// It errors the tests if the number of digits is out of the range.
+func (a AssertableInt) IsInDigitRange(min, max int) AssertableInt {
+	if count := a.actual.DigitCount(); count < min || count > max {
+		a.error(shouldBeInDigitRange(a.actual, min, max))
+	}
+	return a
+}
+
+// IsFactorOf asserts if the assertable int value divides the given number without remainder
+// It errors the tests if the value is not a factor of the number or if the value is zero.
+func (a AssertableInt) IsFactorOf(n int) AssertableInt {
+	if a.actual.IsEqualTo(0) {
+		a.error(shouldNotBeZeroDivisor(a.actual, n))
+		return a
+	}
+	if !a.actual.IsFactorOf(n) {
+		a.error(shouldBeFactorOf(a.actual, n))
+	}
+	return a
+}
+
+// IsCoprimeWith asserts if the assertable int value and the given number have no common divisor other than 1
+// It errors the tests if their greatest common divisor is not 1 or if both of them are zero.
+func (a AssertableInt) IsCoprimeWith(n int) AssertableInt {
+	if a.actual.IsEqualTo(0) && n == 0 {
+		a.error(shouldNotBothBeZero(a.actual, n))
+		return a
+	}
+	if gcd := a.actual.GCD(n); gcd != 1 {
+		a.error(shouldBeCoprimeWith(a.actual, n, gcd))
+	}
+	return a
+}
+
+// AsRomanNumeral returns an AssertableString over the assertable int value written in roman numerals
+// It errors the tests if the value is not between 1 and 3999 and the returned structure holds an empty string.
+func (a AssertableInt) AsRomanNumeral() AssertableString {
+	if !a.actual.IsGreaterOrEqualTo(1) || !a.actual.IsLessOrEqualTo(3999) {
+		a.error(shouldBeInRomanNumeralRange(a.actual))
+		return thatString(a.assertion, "")
+	}
+	return thatString(a.assertion, a.actual.RomanNumeral())
+}
+
+// IsClampedTo asserts if the assertable int value lies within the [min, max] range, inclusive of both bounds, as the
+// result of clamping a value to the range does
+// It errors the tests if
+// * the value is less than min or greater than max
+// * min is greater than max.
+func (a AssertableInt) IsClampedTo(min, max int) AssertableInt {
+	if min > max {
+		a.error(shouldHaveValidRange(min, max))
+		return a
+	}
+	if a.actual.Clamped(min, max) != a.actual.Value() {
+		a.error(shouldBeBetween(a.actual, min, max))
+	}
+	return a
+}
+
+// Clamped returns an AssertableInt over the assertable int value limited to the [min, max] range, that is min if
+// the value is less than min, max if it's greater than max or else the value itself.
+// It errors the tests if min is greater than max and the returned structure holds the value unchanged.
+func (a AssertableInt) Clamped(min, max int) AssertableInt {
+	if min > max {
+		a.error(shouldHaveValidRange(min, max))
+		return a
+	}
+	return thatInt(a.assertion, a.actual.Clamped(min, max))
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableInt) WithMessage(message string) AssertableInt {
+	a.customMessage = message
+	return a
+}
 
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableInt) AsRequirement() AssertableInt {
+	a.requirement = true
 	return a
 }
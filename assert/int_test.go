@@ -187,3 +187,374 @@ func TestAssertableInt_IsLessThanOrEqualTo(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertableInt_IsZero(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert zero",
+			actual: 0,
+		},
+		{
+			name:       "should fail for positive value",
+			actual:     1,
+			shouldFail: true,
+		},
+		{
+			name:       "should assert negative value",
+			actual:     -1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).IsZero()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_HasDigitCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should assert zero has one digit",
+			actual:   0,
+			expected: 1,
+		},
+		{
+			name:     "should assert positive value digits",
+			actual:   12345,
+			expected: 5,
+		},
+		{
+			name:     "should assert negative value digits excluding the sign",
+			actual:   -9876,
+			expected: 4,
+		},
+		{
+			name:     "should assert power of ten digits",
+			actual:   1000,
+			expected: 4,
+		},
+		{
+			name:       "should fail for different digit count",
+			actual:     999,
+			expected:   4,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).HasDigitCount(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_IsInDigitRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		min, max   int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert digit count within range",
+			actual: 12345,
+			min:    4,
+			max:    6,
+		},
+		{
+			name:   "should assert digit count on the lower boundary",
+			actual: -1234,
+			min:    4,
+			max:    6,
+		},
+		{
+			name:   "should assert digit count on the upper boundary",
+			actual: 123456,
+			min:    4,
+			max:    6,
+		},
+		{
+			name:       "should fail for digit count below range",
+			actual:     0,
+			min:        2,
+			max:        3,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for digit count above range",
+			actual:     1234567,
+			min:        4,
+			max:        6,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).IsInDigitRange(tt.min, tt.max)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_IsFactorOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		n          int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert factor",
+			actual: 3,
+			n:      12,
+		},
+		{
+			name:   "should assert negative factor",
+			actual: -4,
+			n:      12,
+		},
+		{
+			name:   "should assert any value is a factor of zero",
+			actual: 7,
+			n:      0,
+		},
+		{
+			name:       "should fail for non factor",
+			actual:     5,
+			n:          12,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero value",
+			actual:     0,
+			n:          12,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).IsFactorOf(tt.n)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_IsCoprimeWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		n          int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert coprime numbers",
+			actual: 8,
+			n:      15,
+		},
+		{
+			name:   "should assert negative coprime numbers",
+			actual: -8,
+			n:      15,
+		},
+		{
+			name:   "should assert one is coprime with zero",
+			actual: 1,
+			n:      0,
+		},
+		{
+			name:       "should fail for numbers with common divisor",
+			actual:     12,
+			n:          18,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero and a number other than one",
+			actual:     0,
+			n:          5,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for both zero",
+			actual:     0,
+			n:          0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).IsCoprimeWith(tt.n)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_AsRomanNumeral(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should convert the lower bound",
+			actual:   1,
+			expected: "I",
+		},
+		{
+			name:     "should convert subtractive notation",
+			actual:   1994,
+			expected: "MCMXCIV",
+		},
+		{
+			name:     "should convert the upper bound",
+			actual:   3999,
+			expected: "MMMCMXCIX",
+		},
+		{
+			name:       "should fail for different numeral",
+			actual:     4,
+			expected:   "IIII",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero",
+			actual:     0,
+			expected:   "",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for value above the upper bound",
+			actual:     4000,
+			expected:   "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).AsRomanNumeral().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_IsClampedTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		min        int
+		max        int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert value within range",
+			actual: 5,
+			min:    0,
+			max:    10,
+		},
+		{
+			name:   "should assert min bound",
+			actual: -10,
+			min:    -10,
+			max:    10,
+		},
+		{
+			name:   "should assert max bound",
+			actual: 10,
+			min:    -10,
+			max:    10,
+		},
+		{
+			name:       "should fail for value below range",
+			actual:     -11,
+			min:        -10,
+			max:        10,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for value above range",
+			actual:     11,
+			min:        -10,
+			max:        10,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid range",
+			actual:     5,
+			min:        10,
+			max:        0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).IsClampedTo(tt.min, tt.max)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableInt_Clamped(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		min        int
+		max        int
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should keep value within range",
+			actual:   5,
+			min:      0,
+			max:      10,
+			expected: 5,
+		},
+		{
+			name:     "should clamp value below range to min",
+			actual:   -20,
+			min:      -10,
+			max:      10,
+			expected: -10,
+		},
+		{
+			name:     "should clamp value above range to max",
+			actual:   20,
+			min:      -10,
+			max:      10,
+			expected: 10,
+		},
+		{
+			name:       "should fail for invalid range",
+			actual:     5,
+			min:        10,
+			max:        0,
+			expected:   5,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatInt(test, tt.actual).Clamped(tt.min, tt.max).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
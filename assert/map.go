@@ -1,6 +1,8 @@
 package assert
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
@@ -9,29 +11,71 @@ import (
 
 // AssertableMap is the structure to assert maps.
 type AssertableMap struct {
-	t      *testing.T
+	assertion
 	actual types.Map
 }
 
 // ThatMap returns a proper assertable structure based on the map key type.
 func ThatMap(t *testing.T, actual interface{}) AssertableMap {
 	t.Helper()
+	return thatMap(assertion{t: t}, actual)
+}
+
+func thatMap(a assertion, actual interface{}) AssertableMap {
 	return AssertableMap{
-		t:      t,
-		actual: values.NewKeyStringMap(actual),
+		assertion: a,
+		actual:    values.NewKeyStringMap(actual),
+	}
+}
+
+// MergedThat merges the overlay map onto the base map and returns an assertable structure over the merged map
+// Last wins: entries of the overlay map replace entries of the base map with the same key.
+// Neither the base nor the overlay map is mutated.
+// It errors the test if any of the given values is not a map or the two maps don't have the same type.
+func MergedThat(t *testing.T, base, overlay interface{}) AssertableMap {
+	t.Helper()
+	if !values.IsMap(base) || !values.IsMap(overlay) || reflect.TypeOf(base) != reflect.TypeOf(overlay) {
+		t.Error(shouldBeMapsOfSameType(base, overlay))
+		return ThatMap(t, base)
 	}
+	return ThatMap(t, values.NewKeyStringMap(base).Merged(overlay))
 }
 
 // IsEqualTo asserts if the expected map is equal to the assertable map value
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableMap) IsEqualTo(expected interface{}) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 
 	if !a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsEqualToIgnoringNilValues asserts if the assertable map is equal to the expected one when keys mapped to a nil or
+// zero value are treated as absent, as they are by encoders that omit empty values. So both {"a": 1, "b": nil} and
+// {"a": 1, "b": 0} are equal to {"a": 1}.
+// It errors the test if
+// * the remaining entries of the two maps are not equal
+// * any of the two values is not a map.
+func (a AssertableMap) IsEqualToIgnoringNilValues(expected interface{}) AssertableMap {
+	if !values.IsMap(a.actual.Value()) {
+		a.error(shouldBeMap(a.actual))
+		return a
+	}
+	if !values.IsMap(expected) {
+		a.error(shouldBeMap(values.NewAnyValue(expected)))
+		return a
+	}
+
+	actual := values.NewKeyStringMap(a.actual.Value())
+	actual = values.NewKeyStringMap(actual.WithoutZeroValues())
+	normalizedExpected := values.NewKeyStringMap(expected).WithoutZeroValues()
+	if !actual.IsEqualTo(normalizedExpected) {
+		a.error(shouldBeEqualIgnoringNilValues(actual, normalizedExpected))
 	}
 	return a
 }
@@ -40,11 +84,11 @@ func (a AssertableMap) IsEqualTo(expected interface{}) AssertableMap {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableMap) IsNotEqualTo(expected interface{}) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -53,35 +97,41 @@ func (a AssertableMap) IsNotEqualTo(expected interface{}) AssertableMap {
 // It errors the test if it doesn't have the expected size.
 func (a AssertableMap) HasSize(size int) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if !a.actual.HasSize(size) {
-		a.t.Error(shouldHaveSize(a.actual, size))
+		a.error(shouldHaveSize(a.actual, size))
 	}
 	return a
 }
 
 // IsEmpty asserts if the assertable string map is empty or not.
+// A nil map is empty.
 func (a AssertableMap) IsEmpty() AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if a.actual.IsNotEmpty() {
-		a.t.Error(shouldBeEmpty(a.actual))
+		a.error(shouldBeEmpty(a.actual))
 	}
 	return a
 }
 
 // IsNotEmpty asserts if the assertable string map is not empty.
+// A nil map is empty and the error reports whether the map is nil or merely empty.
 func (a AssertableMap) IsNotEmpty() AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
+		return a
+	}
+	if values.NewKeyStringMap(a.actual.Value()).IsNil() {
+		a.error(shouldNotBeNilMap(a.actual))
 		return a
 	}
 	if a.actual.IsEmpty() {
-		a.t.Error(shouldNotBeEmpty(a.actual))
+		a.error(shouldNotBeEmpty(a.actual))
 	}
 	return a
 }
@@ -93,11 +143,11 @@ func (a AssertableMap) IsNotEmpty() AssertableMap {
 // * the asserted type is not a map.
 func (a AssertableMap) HasKey(elements interface{}) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if !a.actual.HasKey(elements) {
-		a.t.Error(shouldHaveKey(a.actual, elements))
+		a.error(shouldHaveKey(a.actual, elements))
 	}
 	return a
 }
@@ -109,11 +159,11 @@ func (a AssertableMap) HasKey(elements interface{}) AssertableMap {
 // * the asserted type is not a map.
 func (a AssertableMap) HasValue(elements interface{}) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if !a.actual.HasValue(elements) {
-		a.t.Error(shouldHaveValue(a.actual, elements))
+		a.error(shouldHaveValue(a.actual, elements))
 	}
 	return a
 }
@@ -125,11 +175,11 @@ func (a AssertableMap) HasValue(elements interface{}) AssertableMap {
 // * the asserted type is not a map.
 func (a AssertableMap) HasEntry(value types.MapEntry) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if !a.actual.HasEntry(value) {
-		a.t.Error(shouldHaveEntry(a.actual, value))
+		a.error(shouldHaveEntry(a.actual, value))
 	}
 	return a
 }
@@ -141,11 +191,11 @@ func (a AssertableMap) HasEntry(value types.MapEntry) AssertableMap {
 // * the asserted type is not a map.
 func (a AssertableMap) HasNotKey(elements interface{}) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if a.actual.HasKey(elements) {
-		a.t.Error(shouldNotHaveKey(a.actual, elements))
+		a.error(shouldNotHaveKey(a.actual, elements))
 	}
 	return a
 }
@@ -157,11 +207,11 @@ func (a AssertableMap) HasNotKey(elements interface{}) AssertableMap {
 // * the asserted type is not a map.
 func (a AssertableMap) HasNotValue(elements interface{}) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if a.actual.HasValue(elements) {
-		a.t.Error(shouldNotHaveValue(a.actual, elements))
+		a.error(shouldNotHaveValue(a.actual, elements))
 	}
 	return a
 }
@@ -173,11 +223,75 @@ func (a AssertableMap) HasNotValue(elements interface{}) AssertableMap {
 // * the asserted type is not a map.
 func (a AssertableMap) HasNotEntry(value types.MapEntry) AssertableMap {
 	if !values.IsMap(a.actual.Value()) {
-		a.t.Error(shouldBeMap(a.actual))
+		a.error(shouldBeMap(a.actual))
 		return a
 	}
 	if a.actual.HasEntry(value) {
-		a.t.Error(shouldNotHaveEntry(a.actual, value))
+		a.error(shouldNotHaveEntry(a.actual, value))
 	}
 	return a
 }
+
+// DoesNotHaveKey asserts if the assertable map has not the given key
+// It is an alias of HasNotKey.
+func (a AssertableMap) DoesNotHaveKey(elements interface{}) AssertableMap {
+	return a.HasNotKey(elements)
+}
+
+// DoesNotHaveValue asserts if the assertable map has not the given value
+// It is an alias of HasNotValue.
+func (a AssertableMap) DoesNotHaveValue(elements interface{}) AssertableMap {
+	return a.HasNotValue(elements)
+}
+
+// DoesNotHaveEntry asserts if the assertable map has not the given entry
+// It is an alias of HasNotEntry.
+func (a AssertableMap) DoesNotHaveEntry(value types.MapEntry) AssertableMap {
+	return a.HasNotEntry(value)
+}
+
+// CountEntriesMatchingIs asserts if the number of the assertable map entries that satisfy the given predicate is
+// equal to the expected count
+// It errors the test if
+// * the number of entries satisfying the predicate is different
+// * the asserted type is not a map.
+func (a AssertableMap) CountEntriesMatchingIs(predicate func(key, value interface{}) bool, n int) AssertableMap {
+	if !values.IsMap(a.actual.Value()) {
+		a.error(shouldBeMap(a.actual))
+		return a
+	}
+	if count := values.NewKeyStringMap(a.actual.Value()).CountEntries(predicate); count != n {
+		a.error(shouldHaveEntriesMatchingCount(a.actual, n, count))
+	}
+	return a
+}
+
+// AsJSON marshals the assertable map to JSON and returns an AssertableString over the result
+// The map keys are sorted so the JSON output is deterministic.
+// It errors the test if the asserted type is not a map or it can't be marshaled and the returned structure holds an
+// empty string.
+func (a AssertableMap) AsJSON() AssertableString {
+	if !values.IsMap(a.actual.Value()) {
+		a.error(shouldBeMap(a.actual))
+		return thatString(a.assertion, "")
+	}
+	marshaled, err := json.Marshal(a.actual.Value())
+	if err != nil {
+		a.error(shouldBeMarshalable(a.actual, err))
+		return thatString(a.assertion, "")
+	}
+	return thatString(a.assertion, string(marshaled))
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableMap) WithMessage(message string) AssertableMap {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableMap) AsRequirement() AssertableMap {
+	a.requirement = true
+	return a
+}
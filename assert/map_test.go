@@ -22,6 +22,10 @@ func TestAssertableMap_IsEmpty(t *testing.T) {
 			actual:     map[string]int{"1": 1},
 			shouldFail: true,
 		},
+		{
+			name:   "should assert nil map as empty",
+			actual: map[string]int(nil),
+		},
 		{
 			name:       "should assert a non-map type",
 			actual:     200,
@@ -52,6 +56,11 @@ func TestAssertableMap_IsNotEmpty(t *testing.T) {
 			name:   "should assert non-empty string map",
 			actual: map[string]int{"1": 1},
 		},
+		{
+			name:       "should assert nil map",
+			actual:     map[string]int(nil),
+			shouldFail: true,
+		},
 		{
 			name:       "should assert a non-map type",
 			actual:     200,
@@ -452,3 +461,260 @@ func TestAssertableMap_HasNotEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertableMap_DoesNotHave(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	tests := []struct {
+		name       string
+		actual     interface{}
+		key        interface{}
+		value      interface{}
+		shouldFail bool
+	}{
+		{
+			name:   "should succeed for int keys and string values",
+			actual: map[int]string{1: "one"},
+			key:    2,
+			value:  "two",
+		},
+		{
+			name:   "should succeed for struct keys and slice values",
+			actual: map[point][]int{{X: 1, Y: 2}: {1, 2}},
+			key:    point{X: 2, Y: 1},
+			value:  []int{2, 1},
+		},
+		{
+			name:       "should fail if it has the key and value",
+			actual:     map[point]float64{{X: 1, Y: 2}: 1.5},
+			key:        point{X: 1, Y: 2},
+			value:      1.5,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid types",
+			actual:     []int{1},
+			key:        1,
+			value:      1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatMap(test, tt.actual).
+				DoesNotHaveKey(tt.key).
+				DoesNotHaveValue(tt.value).
+				DoesNotHaveEntry(types.NewMapEntry(tt.key, tt.value))
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestMergedThat(t *testing.T) {
+	tests := []struct {
+		name       string
+		base       interface{}
+		overlay    interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should merge maps with overlay winning",
+			base:     map[string]string{"host": "localhost", "port": "80"},
+			overlay:  map[string]string{"port": "8080", "user": "admin"},
+			expected: map[string]string{"host": "localhost", "port": "8080", "user": "admin"},
+		},
+		{
+			name:     "should merge empty overlay",
+			base:     map[string]int{"1": 1},
+			overlay:  map[string]int{},
+			expected: map[string]int{"1": 1},
+		},
+		{
+			name:     "should merge nil base",
+			base:     map[int]bool(nil),
+			overlay:  map[int]bool{1: true},
+			expected: map[int]bool{1: true},
+		},
+		{
+			name:       "should fail for maps of different types",
+			base:       map[string]int{"1": 1},
+			overlay:    map[string]string{"1": "1"},
+			expected:   map[string]int{"1": 1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-map value",
+			base:       map[string]int{"1": 1},
+			overlay:    12,
+			expected:   map[string]int{"1": 1},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			MergedThat(test, tt.base, tt.overlay).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestMergedThat_DoesNotMutateTheMergedMaps(t *testing.T) {
+	base := map[string]int{"1": 1, "2": 2}
+	overlay := map[string]int{"2": 20, "3": 30}
+
+	MergedThat(t, base, overlay).IsEqualTo(map[string]int{"1": 1, "2": 20, "3": 30})
+	ThatMap(t, base).IsEqualTo(map[string]int{"1": 1, "2": 2})
+	ThatMap(t, overlay).IsEqualTo(map[string]int{"2": 20, "3": 30})
+}
+
+func TestAssertableMap_CountEntriesMatchingIs(t *testing.T) {
+	enabled := func(key, value interface{}) bool {
+		return value.(bool)
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		count      int
+		shouldFail bool
+	}{
+		{
+			name:   "should assert the number of matching entries",
+			actual: map[string]bool{"feature-a": true, "feature-b": false, "feature-c": true},
+			count:  2,
+		},
+		{
+			name:   "should assert no matching entries",
+			actual: map[string]bool{"feature-a": false},
+			count:  0,
+		},
+		{
+			name:   "should assert empty map",
+			actual: map[string]bool{},
+			count:  0,
+		},
+		{
+			name:       "should fail for different number of matching entries",
+			actual:     map[string]bool{"feature-a": true, "feature-b": false, "feature-c": true},
+			count:      1,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-map type",
+			actual:     true,
+			count:      1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatMap(test, tt.actual).CountEntriesMatchingIs(enabled, tt.count)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableMap_AsJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should marshal map with sorted keys",
+			actual:   map[string]interface{}{"name": "go-testing", "stars": 10, "archived": false},
+			expected: `{"archived":false,"name":"go-testing","stars":10}`,
+		},
+		{
+			name:     "should marshal map with int keys",
+			actual:   map[int]string{2: "two", 1: "one"},
+			expected: `{"1":"one","2":"two"}`,
+		},
+		{
+			name:     "should marshal empty map",
+			actual:   map[string]int{},
+			expected: `{}`,
+		},
+		{
+			name:       "should fail for unmarshalable values",
+			actual:     map[string]interface{}{"callback": func() {}},
+			expected:   "",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-map type",
+			actual:     "{}",
+			expected:   "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatMap(test, tt.actual).AsJSON().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableMap_IsEqualToIgnoringNilValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should treat nil values as absent keys",
+			actual:   map[string]interface{}{"a": 1, "b": nil},
+			expected: map[string]interface{}{"a": 1},
+		},
+		{
+			name:     "should treat zero values as absent keys",
+			actual:   map[string]interface{}{"a": 1},
+			expected: map[string]interface{}{"a": 1, "b": "", "c": 0},
+		},
+		{
+			name:     "should treat nil pointers as absent keys",
+			actual:   map[string]*int{"a": nil},
+			expected: map[string]*int{},
+		},
+		{
+			name:       "should fail for different non-zero values",
+			actual:     map[string]interface{}{"a": 1, "b": nil},
+			expected:   map[string]interface{}{"a": 2},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for extra non-zero values",
+			actual:     map[string]int{"a": 1, "b": 2},
+			expected:   map[string]int{"a": 1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-map expected value",
+			actual:     map[string]int{"a": 1},
+			expected:   []int{1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-map value",
+			actual:     1,
+			expected:   map[string]int{"a": 1},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatMap(test, tt.actual).IsEqualToIgnoringNilValues(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
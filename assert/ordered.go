@@ -0,0 +1,91 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
+	"github.com/ppapapetrou76/go-testing/types"
+)
+
+// AssertableOrdered is the type safe assertable structure for values of any ordered type, such as the integer,
+// floating point and string types, compared using the < <= >= > operators.
+type AssertableOrdered[T types.Ordered] struct {
+	assertion
+	actual T
+}
+
+// ThatOrdered returns an AssertableOrdered structure initialized with the test reference and the actual value to
+// assert.
+func ThatOrdered[T types.Ordered](t *testing.T, actual T) AssertableOrdered[T] {
+	t.Helper()
+	return thatOrdered(assertion{t: t}, actual)
+}
+
+func thatOrdered[T types.Ordered](a assertion, actual T) AssertableOrdered[T] {
+	return AssertableOrdered[T]{
+		assertion: a,
+		actual:    actual,
+	}
+}
+
+// IsGreaterThan asserts if the assertable value is greater than the expected value
+// It errors the tests if is not greater.
+func (a AssertableOrdered[T]) IsGreaterThan(expected T) AssertableOrdered[T] {
+	if !(a.actual > expected) {
+		a.error(shouldBeGreater(values.NewAnyValue(a.actual), expected))
+	}
+	return a
+}
+
+// IsGreaterThanOrEqualTo asserts if the assertable value is greater than or equal to the expected value
+// It errors the tests if is not greater or equal.
+func (a AssertableOrdered[T]) IsGreaterThanOrEqualTo(expected T) AssertableOrdered[T] {
+	if !(a.actual >= expected) {
+		a.error(shouldBeGreaterOrEqual(values.NewAnyValue(a.actual), expected))
+	}
+	return a
+}
+
+// IsLessThan asserts if the assertable value is less than the expected value
+// It errors the tests if is not less.
+func (a AssertableOrdered[T]) IsLessThan(expected T) AssertableOrdered[T] {
+	if !(a.actual < expected) {
+		a.error(shouldBeLessThan(values.NewAnyValue(a.actual), expected))
+	}
+	return a
+}
+
+// IsLessThanOrEqualTo asserts if the assertable value is less than or equal to the expected value
+// It errors the tests if is not less or equal.
+func (a AssertableOrdered[T]) IsLessThanOrEqualTo(expected T) AssertableOrdered[T] {
+	if !(a.actual <= expected) {
+		a.error(shouldBeLessOrEqual(values.NewAnyValue(a.actual), expected))
+	}
+	return a
+}
+
+// IsBetween asserts if the assertable value is between the low and high values, both inclusive
+// It errors the tests if the value is out of the range or low is greater than high.
+func (a AssertableOrdered[T]) IsBetween(low, high T) AssertableOrdered[T] {
+	if low > high {
+		a.error(shouldHaveValidRange(low, high))
+		return a
+	}
+	if !(low <= a.actual && a.actual <= high) {
+		a.error(shouldBeBetween(values.NewAnyValue(a.actual), low, high))
+	}
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableOrdered[T]) WithMessage(message string) AssertableOrdered[T] {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableOrdered[T]) AsRequirement() AssertableOrdered[T] {
+	a.requirement = true
+	return a
+}
@@ -0,0 +1,190 @@
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAssertableOrdered_IsGreaterThan(t *testing.T) {
+	tests := []struct {
+		name       string
+		assert     func(test *testing.T)
+		shouldFail bool
+	}{
+		{
+			name:   "should assert greater int",
+			assert: func(test *testing.T) { ThatOrdered(test, 2).IsGreaterThan(1) },
+		},
+		{
+			name:       "should fail for equal int",
+			assert:     func(test *testing.T) { ThatOrdered(test, 2).IsGreaterThan(2) },
+			shouldFail: true,
+		},
+		{
+			name:   "should assert greater float64",
+			assert: func(test *testing.T) { ThatOrdered(test, 0.2).IsGreaterThan(0.1) },
+		},
+		{
+			name:       "should fail for NaN float64",
+			assert:     func(test *testing.T) { ThatOrdered(test, math.NaN()).IsGreaterThan(0.1) },
+			shouldFail: true,
+		},
+		{
+			name:   "should assert lexicographically greater string",
+			assert: func(test *testing.T) { ThatOrdered(test, "b").IsGreaterThan("abc") },
+		},
+		{
+			name:       "should fail for lexicographically less string",
+			assert:     func(test *testing.T) { ThatOrdered(test, "B").IsGreaterThan("a") },
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			tt.assert(test)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableOrdered_IsGreaterThanOrEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		assert     func(test *testing.T)
+		shouldFail bool
+	}{
+		{
+			name:   "should assert equal int",
+			assert: func(test *testing.T) { ThatOrdered(test, 2).IsGreaterThanOrEqualTo(2) },
+		},
+		{
+			name:       "should fail for less float64",
+			assert:     func(test *testing.T) { ThatOrdered(test, 0.1).IsGreaterThanOrEqualTo(0.2) },
+			shouldFail: true,
+		},
+		{
+			name:   "should assert equal string",
+			assert: func(test *testing.T) { ThatOrdered(test, "a").IsGreaterThanOrEqualTo("a") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			tt.assert(test)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableOrdered_IsLessThan(t *testing.T) {
+	tests := []struct {
+		name       string
+		assert     func(test *testing.T)
+		shouldFail bool
+	}{
+		{
+			name:   "should assert less int",
+			assert: func(test *testing.T) { ThatOrdered(test, -1).IsLessThan(0) },
+		},
+		{
+			name:       "should fail for equal float64",
+			assert:     func(test *testing.T) { ThatOrdered(test, 0.5).IsLessThan(0.5) },
+			shouldFail: true,
+		},
+		{
+			name:   "should assert less string",
+			assert: func(test *testing.T) { ThatOrdered(test, "abc").IsLessThan("abd") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			tt.assert(test)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableOrdered_IsLessThanOrEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		assert     func(test *testing.T)
+		shouldFail bool
+	}{
+		{
+			name:   "should assert equal float64",
+			assert: func(test *testing.T) { ThatOrdered(test, 0.5).IsLessThanOrEqualTo(0.5) },
+		},
+		{
+			name:       "should fail for greater int",
+			assert:     func(test *testing.T) { ThatOrdered(test, uint8(3)).IsLessThanOrEqualTo(2) },
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for greater string",
+			assert:     func(test *testing.T) { ThatOrdered(test, "b").IsLessThanOrEqualTo("a") },
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			tt.assert(test)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableOrdered_IsBetween(t *testing.T) {
+	type level int
+	tests := []struct {
+		name       string
+		assert     func(test *testing.T)
+		shouldFail bool
+	}{
+		{
+			name:   "should assert int between bounds",
+			assert: func(test *testing.T) { ThatOrdered(test, 5).IsBetween(1, 10) },
+		},
+		{
+			name:   "should assert int at the bounds",
+			assert: func(test *testing.T) { ThatOrdered(test, 1).IsBetween(1, 10).IsBetween(-1, 1) },
+		},
+		{
+			name:       "should fail for float64 out of bounds",
+			assert:     func(test *testing.T) { ThatOrdered(test, 10.5).IsBetween(1, 10) },
+			shouldFail: true,
+		},
+		{
+			name:   "should assert string between bounds",
+			assert: func(test *testing.T) { ThatOrdered(test, "m").IsBetween("a", "z") },
+		},
+		{
+			name:   "should assert value of derived type",
+			assert: func(test *testing.T) { ThatOrdered(test, level(2)).IsBetween(1, 3) },
+		},
+		{
+			name:       "should fail for invalid range",
+			assert:     func(test *testing.T) { ThatOrdered(test, 5).IsBetween(10, 1) },
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for NaN",
+			assert:     func(test *testing.T) { ThatOrdered(test, math.NaN()).IsBetween(0, 1) },
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for NaN bound",
+			assert:     func(test *testing.T) { ThatOrdered(test, 0.5).IsBetween(0, math.NaN()) },
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			tt.assert(test)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+)
+
+// AssertablePanic is the assertable structure for the outcome of calling a function that may panic.
+type AssertablePanic struct {
+	assertion
+	panicked  bool
+	recovered interface{}
+}
+
+// ThatPanics calls the given function, recovering from any panic, and returns an AssertablePanic structure initialized
+// with the test reference and the outcome of the call to assert.
+func ThatPanics(t *testing.T, fn func()) AssertablePanic {
+	t.Helper()
+	return thatPanics(assertion{t: t}, fn)
+}
+
+func thatPanics(a assertion, fn func()) AssertablePanic {
+	panicked, recovered := callRecovering(fn)
+	return AssertablePanic{
+		assertion: a,
+		panicked:  panicked,
+		recovered: recovered,
+	}
+}
+
+// callRecovering calls the given function and returns whether it panicked and the recovered value. A function panicking with a
+// nil value still counts as panicked.
+func callRecovering(fn func()) (panicked bool, recovered interface{}) {
+	panicked = true
+	defer func() {
+		if panicked {
+			recovered = recover()
+		}
+	}()
+	fn()
+	panicked = false
+	return panicked, nil
+}
+
+// Panics asserts if the function panicked
+// It errors the test if the function returned normally.
+func (a AssertablePanic) Panics() AssertablePanic {
+	if !a.panicked {
+		a.error(shouldPanic())
+	}
+	return a
+}
+
+// DoesNotPanic asserts if the function returned normally
+// It errors the test if the function panicked, reporting the recovered value.
+func (a AssertablePanic) DoesNotPanic() AssertablePanic {
+	if a.panicked {
+		a.error(shouldNotPanic(a.recovered))
+	}
+	return a
+}
+
+// PanicsWith asserts if the function panicked with a value deeply equal to the expected one, using reflect.DeepEqual.
+// It errors the test if the function returned normally or panicked with a different value.
+func (a AssertablePanic) PanicsWith(expected interface{}) AssertablePanic {
+	if !a.panicked {
+		a.error(shouldPanicWith(expected))
+		return a
+	}
+	if !reflect.DeepEqual(a.recovered, expected) {
+		a.error(shouldHavePanicValue(a.recovered, expected))
+	}
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertablePanic) WithMessage(message string) AssertablePanic {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertablePanic) AsRequirement() AssertablePanic {
+	a.requirement = true
+	return a
+}
@@ -0,0 +1,117 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAssertablePanic_Panics(t *testing.T) {
+	tests := []struct {
+		name       string
+		fn         func()
+		shouldFail bool
+	}{
+		{
+			name: "should assert panic with a string",
+			fn:   func() { panic("boom") },
+		},
+		{
+			name: "should assert panic with an error",
+			fn:   func() { panic(errors.New("boom")) },
+		},
+		{
+			name: "should assert runtime panic",
+			fn: func() {
+				var m map[string]int
+				m["a"] = 1
+			},
+		},
+		{
+			name:       "should fail for non-panicking function",
+			fn:         func() {},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatPanics(test, tt.fn).Panics()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertablePanic_DoesNotPanic(t *testing.T) {
+	tests := []struct {
+		name       string
+		fn         func()
+		shouldFail bool
+	}{
+		{
+			name: "should assert non-panicking function",
+			fn:   func() {},
+		},
+		{
+			name:       "should fail for panic with a string",
+			fn:         func() { panic("boom") },
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for panic with an error",
+			fn:         func() { panic(errors.New("boom")) },
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatPanics(test, tt.fn).DoesNotPanic()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertablePanic_PanicsWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		fn         func()
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should assert panic with a string",
+			fn:       func() { panic("boom") },
+			expected: "boom",
+		},
+		{
+			name:     "should assert panic with a deeply equal error",
+			fn:       func() { panic(errors.New("boom")) },
+			expected: errors.New("boom"),
+		},
+		{
+			name:       "should fail for panic with another string",
+			fn:         func() { panic("boom") },
+			expected:   "bang",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for panic with a value of another type",
+			fn:         func() { panic(errors.New("boom")) },
+			expected:   "boom",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for non-panicking function",
+			fn:         func() {},
+			expected:   "boom",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatPanics(test, tt.fn).PanicsWith(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
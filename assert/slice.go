@@ -1,6 +1,8 @@
 package assert
 
 import (
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
@@ -12,9 +14,16 @@ type SliceOpt func(*AssertableSlice)
 
 // AssertableSlice is the implementation of AssertableSlice for string slices.
 type AssertableSlice struct {
-	t             *testing.T
+	assertion
 	actual        types.Containable
-	customMessage string
+	sortSequences bool
+}
+
+// Run is a run of consecutive equal elements of a slice, used by run-length encoding assertions: the repeated element
+// and the number of its consecutive repetitions.
+type Run struct {
+	Value interface{}
+	Count int
 }
 
 // WithCustomMessage provides a custom message to be added before the assertion error message.
@@ -24,12 +33,26 @@ func WithCustomMessage(customMessage string) SliceOpt {
 	}
 }
 
+// SortingSequences sets sequence assertions to sort the elements in ascending order before checking them, instead of
+// requiring the elements to be already sorted.
+func SortingSequences() SliceOpt {
+	return func(c *AssertableSlice) {
+		c.sortSequences = true
+	}
+}
+
 // ThatSlice returns a proper assertable structure based on the slice type.
+// It doesn't panic if the given value is not a slice: such a value has no elements, so assertions such as HasSize,
+// IsNotEmpty and Contains error the test.
 func ThatSlice(t *testing.T, actual interface{}, opts ...SliceOpt) AssertableSlice {
 	t.Helper()
+	return thatSlice(assertion{t: t}, actual, opts...)
+}
+
+func thatSlice(a assertion, actual interface{}, opts ...SliceOpt) AssertableSlice {
 	assertable := &AssertableSlice{
-		t:      t,
-		actual: values.NewSliceValue(actual),
+		assertion: a,
+		actual:    values.NewSliceValue(actual),
 	}
 	for _, opt := range opts {
 		opt(assertable)
@@ -37,11 +60,132 @@ func ThatSlice(t *testing.T, actual interface{}, opts ...SliceOpt) AssertableSli
 	return *assertable
 }
 
+// SumOfFieldThat maps every element of the given slice to a number using the extract function and returns an
+// AssertableFloat64 over their sum. Empty slices yield zero.
+// It errors the test if the given value is not a slice and the returned structure holds zero.
+func SumOfFieldThat(t *testing.T, slice interface{}, extract func(element interface{}) float64) AssertableFloat64 {
+	t.Helper()
+	if !values.IsSlice(slice) {
+		t.Error(shouldBeSlice(values.NewSliceValue(slice)))
+		return ThatFloat64(t, 0)
+	}
+	return ThatFloat64(t, values.NewSliceValue(slice).Sum(extract))
+}
+
+// PartitionThat splits the given slice into the elements that satisfy the predicate and the ones that don't and returns
+// an assertable structure over each of the partitions, preserving the order of the elements.
+// It errors the test if the given value is not a slice and both returned structures hold no elements.
+func PartitionThat(t *testing.T, slice interface{}, predicate func(element interface{}) bool) (matching, nonMatching AssertableSlice) {
+	t.Helper()
+	if !values.IsSlice(slice) {
+		t.Error(shouldBeSlice(values.NewSliceValue(slice)))
+		return ThatSlice(t, []interface{}{}), ThatSlice(t, []interface{}{})
+	}
+	matchingElements, nonMatchingElements := values.NewSliceValue(slice).Partition(predicate)
+	return ThatSlice(t, matchingElements), ThatSlice(t, nonMatchingElements)
+}
+
+// IndexByThat builds a map of the elements of the given slice keyed by the key the given function extracts from each
+// element and returns an assertable structure over it. The map keys have the type of the keys if all of them have the
+// same non-nil type, else interface{}.
+// It errors the test if the given value is not a slice, a key is not comparable or two elements have the same key and
+// the returned structure holds an empty map.
+func IndexByThat(t *testing.T, slice interface{}, keyFn func(element interface{}) interface{}) AssertableMap {
+	t.Helper()
+	if !values.IsSlice(slice) {
+		t.Error(shouldBeSlice(values.NewSliceValue(slice)))
+		return ThatMap(t, map[interface{}]interface{}{})
+	}
+	index, invalidKey, valid := values.NewSliceValue(slice).IndexBy(keyFn)
+	if !valid {
+		if values.IsComparableKey(invalidKey) {
+			t.Error(shouldHaveUniqueKeys(values.NewSliceValue(slice), invalidKey))
+		} else {
+			t.Error(shouldHaveComparableKeys(values.NewSliceValue(slice), invalidKey))
+		}
+		return ThatMap(t, map[interface{}]interface{}{})
+	}
+	return ThatMap(t, index)
+}
+
+// FlatMapThat applies the given function to every element of the given slice, concatenates the slices it returns and
+// returns an assertable structure over the flattened slice. The flattened slice has the type of the returned slices
+// or []interface{} if the given slice is empty.
+// It errors the test if the given value is not a slice or the function returns values that are not slices of the
+// same type.
+func FlatMapThat(t *testing.T, slice interface{}, fn func(element interface{}) interface{}) AssertableSlice {
+	t.Helper()
+	if !values.IsSlice(slice) {
+		t.Error(shouldBeSlice(values.NewSliceValue(slice)))
+		return ThatSlice(t, []interface{}{})
+	}
+
+	sliceValue := reflect.ValueOf(slice)
+	flattened := reflect.ValueOf([]interface{}{})
+	for i := 0; i < sliceValue.Len(); i++ {
+		mapped := reflect.ValueOf(fn(sliceValue.Index(i).Interface()))
+		if mapped.Kind() != reflect.Slice || (i > 0 && mapped.Type() != flattened.Type()) {
+			t.Error(shouldBeFlatMappedToSlices(flattened.Interface(), mapped))
+			return ThatSlice(t, []interface{}{})
+		}
+		if i == 0 {
+			flattened = reflect.MakeSlice(mapped.Type(), 0, mapped.Len())
+		}
+		flattened = reflect.AppendSlice(flattened, mapped)
+	}
+	return ThatSlice(t, flattened.Interface())
+}
+
+// ZipEqualThat asserts if the two given slices have the same size and every pair of their elements at the same index
+// satisfies the given relation.
+// It errors the test if
+// * any of the given values is not a slice
+// * the slices have different sizes
+// * any pair of aligned elements doesn't satisfy the relation, reporting the first offending index.
+func ZipEqualThat(t *testing.T, as, bs interface{}, eq func(a, b interface{}) bool) {
+	t.Helper()
+	if !values.IsSlice(as) || !values.IsSlice(bs) {
+		t.Error(shouldBeSlices(as, bs))
+		return
+	}
+	asValue, bsValue := reflect.ValueOf(as), reflect.ValueOf(bs)
+	if asValue.Len() != bsValue.Len() {
+		t.Error(shouldHaveSameSizeSlices(as, bs))
+		return
+	}
+	for i := 0; i < asValue.Len(); i++ {
+		a, b := asValue.Index(i).Interface(), bsValue.Index(i).Interface()
+		if !eq(a, b) {
+			t.Error(shouldHaveZippedElementsSatisfying(i, a, b))
+			return
+		}
+	}
+}
+
 // IsEqualTo asserts if the expected slice is equal to the assertable slice value
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableSlice) IsEqualTo(expected interface{}) AssertableSlice {
 	if !a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsEqualToIgnoringNils asserts if the assertable slice is equal to the expected one after removing the nil elements
+// of both, such as nil pointers or interfaces. The order of the remaining elements matters and they are compared with
+// reflect.DeepEqual, so pointers are equal if they point to deeply equal values.
+// It errors the test if
+// * the remaining elements of the two slices are not equal
+// * any of the two values is not a slice.
+func (a AssertableSlice) IsEqualToIgnoringNils(expected interface{}) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) || !values.IsSlice(expected) {
+		a.error(shouldBeSlices(a.actual.Value(), expected))
+		return a
+	}
+	actual := values.NewSliceValue(values.NewSliceValue(a.actual.Value()).WithoutNils())
+	filteredExpected := values.NewSliceValue(expected).WithoutNils()
+	if !reflect.DeepEqual(actual.Value(), filteredExpected) {
+		a.error(shouldBeDeepEqual(actual, filteredExpected))
 	}
 	return a
 }
@@ -50,7 +194,7 @@ func (a AssertableSlice) IsEqualTo(expected interface{}) AssertableSlice {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableSlice) IsNotEqualTo(expected interface{}) AssertableSlice {
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -59,7 +203,7 @@ func (a AssertableSlice) IsNotEqualTo(expected interface{}) AssertableSlice {
 // It errors the test if it doesn't have the expected size.
 func (a AssertableSlice) HasSize(size int) AssertableSlice {
 	if !a.actual.HasSize(size) {
-		a.t.Error(shouldHaveSize(a.actual, size))
+		a.error(shouldHaveSize(a.actual, size))
 	}
 	return a
 }
@@ -67,7 +211,7 @@ func (a AssertableSlice) HasSize(size int) AssertableSlice {
 // IsEmpty asserts if the assertable string slice is empty or not.
 func (a AssertableSlice) IsEmpty() AssertableSlice {
 	if a.actual.IsNotEmpty() {
-		a.t.Error(shouldBeEmpty(a.actual))
+		a.error(shouldBeEmpty(a.actual))
 	}
 	return a
 }
@@ -75,7 +219,7 @@ func (a AssertableSlice) IsEmpty() AssertableSlice {
 // IsNotEmpty asserts if the assertable string slice is not empty.
 func (a AssertableSlice) IsNotEmpty() AssertableSlice {
 	if a.actual.IsEmpty() {
-		a.t.Error(shouldNotBeEmpty(a.actual))
+		a.error(shouldNotBeEmpty(a.actual))
 	}
 	return a
 }
@@ -84,7 +228,37 @@ func (a AssertableSlice) IsNotEmpty() AssertableSlice {
 // It errors the test if it does not contain it/them.
 func (a AssertableSlice) Contains(elements interface{}) AssertableSlice {
 	if a.actual.DoesNotContain(elements) {
-		a.t.Error(shouldContain(a.actual, elements))
+		a.error(shouldContain(a.actual, elements))
+	}
+	return a
+}
+
+// ContainsAllOf asserts if the assertable slice contains every element of the other slice
+// It errors the test if
+// * it doesn't contain all of the elements, reporting the missing ones
+// * any of the two values is not a slice.
+func (a AssertableSlice) ContainsAllOf(other interface{}) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) || !values.IsSlice(other) {
+		a.error(shouldBeSlices(a.actual.Value(), other))
+		return a
+	}
+	if missing := values.NewSliceValue(other).Difference(a.actual.Value()); values.NewSliceValue(missing).IsNotEmpty() {
+		a.error(shouldContain(a.actual, missing))
+	}
+	return a
+}
+
+// ContainsAnyOf asserts if the assertable slice contains at least one element of the other slice
+// It errors the test if
+// * it contains none of the elements, which is always the case for an empty other slice
+// * any of the two values is not a slice.
+func (a AssertableSlice) ContainsAnyOf(other interface{}) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) || !values.IsSlice(other) {
+		a.error(shouldBeSlices(a.actual.Value(), other))
+		return a
+	}
+	if common := values.NewSliceValue(a.actual.Value()).Intersection(other); values.NewSliceValue(common).IsEmpty() {
+		a.error(shouldContainAnyOf(a.actual, other))
 	}
 	return a
 }
@@ -93,7 +267,47 @@ func (a AssertableSlice) Contains(elements interface{}) AssertableSlice {
 // It errors the test if it does not contain it/them.
 func (a AssertableSlice) ContainsOnly(elements interface{}) AssertableSlice {
 	if !a.actual.ContainsOnly(elements) {
-		a.t.Error(shouldContainOnly(a.actual, elements))
+		a.error(shouldContainOnly(a.actual, elements))
+	}
+	return a
+}
+
+// ContainsExactly asserts if the assertable slice has exactly the given elements in the same order. The elements are
+// compared using reflect.DeepEqual.
+// It errors the test if the asserted value is not a slice or its elements differ, reporting the missing and extra
+// elements, or the first index holding a different element if only their order differs.
+func (a AssertableSlice) ContainsExactly(elements ...interface{}) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return a
+	}
+	missing, extra := values.NewSliceValue(a.actual.Value()).ElementsDifference(elements)
+	if len(missing) > 0 || len(extra) > 0 {
+		a.error(shouldContainExactly(a.actual, elements, "in the same order", missing, extra))
+		return a
+	}
+	actualValue := reflect.ValueOf(a.actual.Value())
+	for i, element := range elements {
+		if found := actualValue.Index(i).Interface(); !reflect.DeepEqual(found, element) {
+			a.error(shouldContainExactlyInOrder(a.actual, elements, i, element, found))
+			return a
+		}
+	}
+	return a
+}
+
+// ContainsExactlyInAnyOrder asserts if the assertable slice has exactly the given elements in any order, each of them
+// as many times as it's given. The elements are compared using reflect.DeepEqual.
+// It errors the test if the asserted value is not a slice or its elements differ, reporting the missing and extra
+// elements.
+func (a AssertableSlice) ContainsExactlyInAnyOrder(elements ...interface{}) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return a
+	}
+	missing, extra := values.NewSliceValue(a.actual.Value()).ElementsDifference(elements)
+	if len(missing) > 0 || len(extra) > 0 {
+		a.error(shouldContainExactly(a.actual, elements, "in any order", missing, extra))
 	}
 	return a
 }
@@ -102,7 +316,269 @@ func (a AssertableSlice) ContainsOnly(elements interface{}) AssertableSlice {
 // It errors the test if it contains it/them.
 func (a AssertableSlice) DoesNotContain(elements interface{}) AssertableSlice {
 	if a.actual.Contains(elements) {
-		a.t.Error(shouldNotContain(a.actual, elements))
+		a.error(shouldNotContain(a.actual, elements))
 	}
 	return a
 }
+
+// InChunksOf partitions the assertable slice into chunks of the given size and returns an assertable slice over them
+// The last chunk holds the remaining elements if the slice size is not a multiple of the given size.
+// It errors the test if the given size is not positive or the asserted value is not a slice.
+func (a AssertableSlice) InChunksOf(size int) AssertableSlice {
+	chunks := AssertableSlice{
+		assertion: a.assertion,
+		actual:    values.NewSliceValue(nil),
+	}
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return chunks
+	}
+	if size <= 0 {
+		a.error(shouldHavePositiveSize(size))
+		return chunks
+	}
+	chunks.actual = values.NewSliceValue(values.NewSliceValue(a.actual.Value()).Chunks(size))
+	return chunks
+}
+
+// EveryWindowOfSizeSatisfies asserts if every window of the given size sliding over the assertable slice satisfies
+// the given predicate. Each window is passed to the predicate as a sub-slice of the same type as the asserted slice.
+// It errors the test if
+// * any window doesn't satisfy the predicate
+// * the size is not positive or greater than the slice size
+// * the asserted value is not a slice.
+func (a AssertableSlice) EveryWindowOfSizeSatisfies(size int, predicate func(window interface{}) bool) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return a
+	}
+	if size <= 0 || size > a.actual.Size() {
+		a.error(shouldHaveValidWindowSize(a.actual, size))
+		return a
+	}
+	if index := values.NewSliceValue(a.actual.Value()).FirstWindowNotSatisfying(size, predicate); index != -1 {
+		a.error(shouldHaveAllWindowsSatisfying(a.actual, size, index))
+	}
+	return a
+}
+
+// EachElementAtIndexSatisfies asserts if every element of the assertable slice satisfies the given predicate, which
+// is given the index and the value of each element, so that position dependent properties can be asserted.
+// It errors the test if the asserted value is not a slice or on the first element that doesn't satisfy the predicate.
+func (a AssertableSlice) EachElementAtIndexSatisfies(predicate func(i int, element interface{}) bool) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return a
+	}
+	slice := values.NewSliceValue(a.actual.Value())
+	if index := slice.FirstElementNotSatisfying(predicate); index != -1 {
+		a.error(shouldHaveElementAtIndexSatisfying(a.actual, index, reflect.ValueOf(slice.Value()).Index(index).Interface()))
+	}
+	return a
+}
+
+// HasRunLengthEncoding asserts if the run-length encoding of the assertable slice, that is the runs of its consecutive
+// equal elements in order, is equal to the expected runs. For example [a a b a] is encoded as [{a 2} {b 1} {a 1}].
+// The run values are compared using reflect.DeepEqual, so they must have the type of the slice elements.
+// It errors the test if the asserted value is not a slice or its runs differ from the expected ones, reporting the
+// first differing run.
+func (a AssertableSlice) HasRunLengthEncoding(expected []Run) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return a
+	}
+	encoded := values.NewSliceValue(a.actual.Value()).RunLengthEncoding()
+	actual := make([]Run, 0, len(encoded))
+	for _, run := range encoded {
+		actual = append(actual, Run{Value: run.Value, Count: run.Count})
+	}
+	for i := 0; i < len(actual) || i < len(expected); i++ {
+		if i >= len(actual) || i >= len(expected) || !reflect.DeepEqual(actual[i], expected[i]) {
+			a.error(shouldHaveRunLengthEncoding(a.actual, expected, actual, i))
+			return a
+		}
+	}
+	return a
+}
+
+// IsSorted asserts if the elements of the assertable slice are sorted in ascending order, equal elements being
+// allowed next to each other. Slices of integers, floating point numbers and strings are compared by their natural
+// order, other slices need the less function reporting whether the element at index i is less than the one at index j.
+// It errors the test if
+// * the asserted value is not a slice
+// * the elements have no natural order and no less function is given
+// * the elements are not sorted, reporting the first index where the order breaks.
+func (a AssertableSlice) IsSorted(less ...func(i, j int) bool) AssertableSlice {
+	return a.isSorted("ascending", false, less)
+}
+
+// IsSortedDescending asserts if the elements of the assertable slice are sorted in descending order, equal elements
+// being allowed next to each other. Slices of integers, floating point numbers and strings are compared by their
+// natural order, other slices need the less function reporting whether the element at index i is less than the one at
+// index j.
+// It errors the test if
+// * the asserted value is not a slice
+// * the elements have no natural order and no less function is given
+// * the elements are not sorted, reporting the first index where the order breaks.
+func (a AssertableSlice) IsSortedDescending(less ...func(i, j int) bool) AssertableSlice {
+	return a.isSorted("descending", true, less)
+}
+
+func (a AssertableSlice) isSorted(order string, descending bool, less []func(i, j int) bool) AssertableSlice {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return a
+	}
+	slice := values.NewSliceValue(a.actual.Value())
+	lessFn, ok := slice.NaturalLess()
+	if len(less) > 0 && less[0] != nil {
+		lessFn, ok = less[0], true
+	}
+	if !ok {
+		a.error(shouldBeSortable(a.actual))
+		return a
+	}
+	if descending {
+		ascendingLess := lessFn
+		lessFn = func(i, j int) bool { return ascendingLess(j, i) }
+	}
+	if index := slice.FirstUnsortedIndex(lessFn); index != -1 {
+		a.error(shouldBeSorted(a.actual, order, index))
+	}
+	return a
+}
+
+// DeepEqualTo asserts if the assertable slice is deeply equal to the expected slice using reflect.DeepEqual
+// It errors the test if the slices are not equal, reporting the element and field level changes, for example
+// index [2].Name: "a" -> "b".
+func (a AssertableSlice) DeepEqualTo(expected interface{}) AssertableSlice {
+	if !reflect.DeepEqual(a.actual.Value(), expected) {
+		a.error(shouldBeDeepEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IntersectionWith returns an AssertableSlice over the distinct elements of the assertable slice that the other slice
+// also contains. The elements keep the order they have in the assertable slice.
+// It errors the test if any of the two values is not a slice and the returned structure holds no slice.
+func (a AssertableSlice) IntersectionWith(other interface{}) AssertableSlice {
+	return a.setOperation(other, values.SliceValue.Intersection)
+}
+
+// DifferenceFrom returns an AssertableSlice over the distinct elements of the assertable slice that the other slice
+// doesn't contain. The elements keep the order they have in the assertable slice.
+// It errors the test if any of the two values is not a slice and the returned structure holds no slice.
+func (a AssertableSlice) DifferenceFrom(other interface{}) AssertableSlice {
+	return a.setOperation(other, values.SliceValue.Difference)
+}
+
+func (a AssertableSlice) setOperation(other interface{}, operation func(values.SliceValue, interface{}) interface{}) AssertableSlice {
+	result := AssertableSlice{
+		assertion: a.assertion,
+		actual:    values.NewSliceValue(nil),
+	}
+	if !values.IsSlice(a.actual.Value()) || !values.IsSlice(other) {
+		a.error(shouldBeSlices(a.actual.Value(), other))
+		return result
+	}
+	result.actual = values.NewSliceValue(operation(values.NewSliceValue(a.actual.Value()), other))
+	return result
+}
+
+// MaxBy returns an AssertableAny over the greatest element of the assertable slice according to the given less
+// function, so assertions can be chained on it. If many elements are the greatest, the first of them is returned.
+// It errors the test if the asserted value is not a non-empty slice and the returned structure holds a nil value.
+func (a AssertableSlice) MaxBy(less func(a, b interface{}) bool) AssertableAny {
+	if !a.isNonEmptySlice() {
+		return that(a.assertion, nil)
+	}
+	return that(a.assertion, values.NewSliceValue(a.actual.Value()).MaxBy(less))
+}
+
+// MinBy returns an AssertableAny over the least element of the assertable slice according to the given less
+// function, so assertions can be chained on it. If many elements are the least, the first of them is returned.
+// It errors the test if the asserted value is not a non-empty slice and the returned structure holds a nil value.
+func (a AssertableSlice) MinBy(less func(a, b interface{}) bool) AssertableAny {
+	if !a.isNonEmptySlice() {
+		return that(a.assertion, nil)
+	}
+	return that(a.assertion, values.NewSliceValue(a.actual.Value()).MinBy(less))
+}
+
+func (a AssertableSlice) isNonEmptySlice() bool {
+	if !values.IsSlice(a.actual.Value()) {
+		a.error(shouldBeSlice(a.actual))
+		return false
+	}
+	if a.actual.IsEmpty() {
+		a.error(shouldNotBeEmpty(a.actual))
+		return false
+	}
+	return true
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableSlice) WithMessage(message string) AssertableSlice {
+	a.customMessage = message
+	return a
+}
+
+// IsContiguousSequence asserts if the elements of the assertable integer slice form a contiguous run, such as
+// [3 4 5 6], where each element is one greater than the previous one. The elements must be sorted in ascending order
+// unless SortingSequences is set. Empty slices and slices of one element are contiguous.
+// It errors the test if
+// * there is a gap in the sequence, reporting the first missing value
+// * the asserted value is not a slice of integers.
+func (a AssertableSlice) IsContiguousSequence() AssertableSlice {
+	return a.HasNoGaps(1)
+}
+
+// HasNoGaps asserts if the elements of the assertable integer slice form an arithmetic progression with the given
+// step, such as [0 10 20 30] for step 10, where each element is step greater than the previous one. The elements must
+// be sorted in ascending order unless SortingSequences is set.
+// It errors the test if
+// * there is a gap in the sequence, reporting the first missing value
+// * an element is out of order or duplicate, reporting its index and the value expected instead of it
+// * the asserted value is not a slice of integers.
+func (a AssertableSlice) HasNoGaps(step int) AssertableSlice {
+	elements, ok := values.NewSliceValue(a.actual.Value()).IntElements()
+	if !ok {
+		a.error(shouldBeIntSlice(a.actual))
+		return a
+	}
+	if a.sortSequences {
+		sort.Slice(elements, func(i, j int) bool {
+			return elements[i] < elements[j]
+		})
+	}
+	for i := 1; i < len(elements); i++ {
+		expected := elements[i-1] + int64(step)
+		if elements[i] == expected {
+			continue
+		}
+		if skipped := (step > 0 && elements[i] > expected) || (step < 0 && elements[i] < expected); skipped &&
+			!containsInt64(elements, expected) {
+			a.error(shouldHaveNoGaps(a.actual, expected, elements[i]))
+		} else {
+			a.error(shouldHaveNextInSequence(a.actual, expected, elements[i-1], elements[i], i))
+		}
+		return a
+	}
+	return a
+}
+
+func containsInt64(elements []int64, value int64) bool {
+	for _, element := range elements {
+		if element == value {
+			return true
+		}
+	}
+	return false
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableSlice) AsRequirement() AssertableSlice {
+	a.requirement = true
+	return a
+}
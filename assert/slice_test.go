@@ -178,6 +178,18 @@ func TestAssertableSlice_HasSize(t *testing.T) {
 			expectedSize: 1,
 			shouldFail:   true,
 		},
+		{
+			name:         "should succeed if an int slice has the expected size",
+			actual:       []int{1, 2, 3},
+			expectedSize: 3,
+			shouldFail:   false,
+		},
+		{
+			name:         "should succeed if a struct slice has the expected size",
+			actual:       []struct{ Value int }{{Value: 1}},
+			expectedSize: 1,
+			shouldFail:   false,
+		},
 		{
 			name:         "should fail if it runs for wrong type",
 			actual:       12,
@@ -238,6 +250,18 @@ func TestAssertableSlice_Contains(t *testing.T) {
 			elementsToContain: []string{"element", "element4"},
 			shouldFail:        true,
 		},
+		{
+			name:              "should succeed if an int slice contains the expected element",
+			actual:            []int{-1, 0, 1},
+			elementsToContain: -1,
+			shouldFail:        false,
+		},
+		{
+			name:              "should fail if an int slice does not contain the expected element",
+			actual:            []int{-1, 0, 1},
+			elementsToContain: 2,
+			shouldFail:        true,
+		},
 		{
 			name: "should succeed if contains runs on a single element not wrapped as slice",
 			actual: []testStruct{
@@ -296,9 +320,13 @@ func TestAssertableSlice_ContainsOnly(t *testing.T) {
 }
 
 func TestAssertableSlice_DoesNotContain(t *testing.T) {
+	type testStruct struct {
+		Value int
+	}
+
 	tests := []struct {
 		name              string
-		actual            []string
+		actual            interface{}
 		elementsToContain interface{}
 		shouldFail        bool
 	}{
@@ -320,6 +348,24 @@ func TestAssertableSlice_DoesNotContain(t *testing.T) {
 			elementsToContain: []string{"element", "element2"},
 			shouldFail:        true,
 		},
+		{
+			name:              "should succeed if an int slice doesn't contain the expected element",
+			actual:            []int{1, 2},
+			elementsToContain: 3,
+			shouldFail:        false,
+		},
+		{
+			name:              "should succeed if a struct slice doesn't contain the expected element",
+			actual:            []testStruct{{Value: 1}},
+			elementsToContain: testStruct{Value: 2},
+			shouldFail:        false,
+		},
+		{
+			name:              "should fail if a struct slice contains the expected element",
+			actual:            []testStruct{{Value: 1}},
+			elementsToContain: testStruct{Value: 1},
+			shouldFail:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -329,3 +375,1333 @@ func TestAssertableSlice_DoesNotContain(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertableSlice_InChunksOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		size       int
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should partition slice with a remainder chunk",
+			actual:   []int{1, 2, 3, 4, 5, 6, 7},
+			size:     3,
+			expected: [][]int{{1, 2, 3}, {4, 5, 6}, {7}},
+		},
+		{
+			name:     "should partition slice without a remainder chunk",
+			actual:   []string{"a", "b", "c", "d"},
+			size:     2,
+			expected: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name:     "should partition array",
+			actual:   [3]int{1, 2, 3},
+			size:     2,
+			expected: [][]int{{1, 2}, {3}},
+		},
+		{
+			name:     "should return no chunks for empty slice",
+			actual:   []int{},
+			size:     2,
+			expected: [][]int{},
+		},
+		{
+			name:       "should fail if chunks are not the expected ones",
+			actual:     []int{1, 2, 3},
+			size:       2,
+			expected:   [][]int{{1}, {2, 3}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero size",
+			actual:     []int{1, 2, 3},
+			size:       0,
+			expected:   [][]int{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			size:       1,
+			expected:   [][]int{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).InChunksOf(tt.size).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_EachElementAtIndexSatisfies(t *testing.T) {
+	positiveAtEvenIndex := func(i int, element interface{}) bool {
+		return i%2 != 0 || element.(int) > 0
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		predicate  func(i int, element interface{}) bool
+		shouldFail bool
+	}{
+		{
+			name:      "should succeed if every element satisfies the predicate",
+			actual:    []int{1, -1, 2, -2, 3},
+			predicate: positiveAtEvenIndex,
+		},
+		{
+			name:       "should fail if an element doesn't satisfy the predicate",
+			actual:     []int{1, -1, -2},
+			predicate:  positiveAtEvenIndex,
+			shouldFail: true,
+		},
+		{
+			name:      "should succeed for an empty slice",
+			actual:    []int{},
+			predicate: positiveAtEvenIndex,
+		},
+		{
+			name:   "should succeed for non-comparable elements",
+			actual: [][]int{{}, {1}, {1, 2}},
+			predicate: func(i int, element interface{}) bool {
+				return len(element.([]int)) == i
+			},
+		},
+		{
+			name:   "should fail for non-comparable elements",
+			actual: []map[string]int{{"a": 1}, {}},
+			predicate: func(i int, element interface{}) bool {
+				return len(element.(map[string]int)) > 0
+			},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			predicate:  positiveAtEvenIndex,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).EachElementAtIndexSatisfies(tt.predicate)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_HasRunLengthEncoding(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   []Run
+		shouldFail bool
+	}{
+		{
+			name:     "should assert runs of consecutive elements",
+			actual:   []string{"a", "a", "b", "a"},
+			expected: []Run{{Value: "a", Count: 2}, {Value: "b", Count: 1}, {Value: "a", Count: 1}},
+		},
+		{
+			name:     "should assert single run",
+			actual:   []int{7, 7, 7},
+			expected: []Run{{Value: 7, Count: 3}},
+		},
+		{
+			name:     "should assert empty slice without runs",
+			actual:   []int{},
+			expected: []Run{},
+		},
+		{
+			name:       "should fail for different run count",
+			actual:     []int{1, 1, 2},
+			expected:   []Run{{Value: 1, Count: 1}, {Value: 2, Count: 1}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for missing run",
+			actual:     []int{1, 1, 2},
+			expected:   []Run{{Value: 1, Count: 2}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for extra run",
+			actual:     []int{1, 1},
+			expected:   []Run{{Value: 1, Count: 2}, {Value: 2, Count: 1}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for run value of other type",
+			actual:     []int64{1},
+			expected:   []Run{{Value: 1, Count: 1}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			expected:   []Run{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).HasRunLengthEncoding(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_ContainsExactly(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		elements   []interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should assert same elements in the same order",
+			actual:   []string{"a", "b", "a"},
+			elements: []interface{}{"a", "b", "a"},
+		},
+		{
+			name:     "should assert deeply equal elements",
+			actual:   [][]int{{1}, {2, 3}},
+			elements: []interface{}{[]int{1}, []int{2, 3}},
+		},
+		{
+			name:     "should assert empty slice",
+			actual:   []int{},
+			elements: nil,
+		},
+		{
+			name:       "should fail for same elements in other order",
+			actual:     []int{1, 2, 3},
+			elements:   []interface{}{3, 2, 1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for missing duplicate",
+			actual:     []int{1, 2},
+			elements:   []interface{}{1, 2, 2},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for extra element",
+			actual:     []int{1, 2, 3},
+			elements:   []interface{}{1, 2},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for elements of other type",
+			actual:     []int64{1},
+			elements:   []interface{}{1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			elements:   []interface{}{12},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).ContainsExactly(tt.elements...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_ContainsExactlyInAnyOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		elements   []interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should assert same elements in other order",
+			actual:   []int{1, 2, 3},
+			elements: []interface{}{3, 1, 2},
+		},
+		{
+			name:     "should assert duplicates",
+			actual:   []string{"a", "b", "a"},
+			elements: []interface{}{"a", "a", "b"},
+		},
+		{
+			name:       "should fail for different multiplicity",
+			actual:     []string{"a", "b", "a"},
+			elements:   []interface{}{"a", "b", "b"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for fewer elements",
+			actual:     []int{1, 2},
+			elements:   []interface{}{2, 1, 3},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for more elements",
+			actual:     []int{1, 2, 3},
+			elements:   []interface{}{2, 1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			elements:   []interface{}{12},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).ContainsExactlyInAnyOrder(tt.elements...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_IsSorted(t *testing.T) {
+	people := []struct{ age int }{{age: 20}, {age: 30}, {age: 40}}
+	byAge := func(i, j int) bool { return people[i].age < people[j].age }
+	tests := []struct {
+		name       string
+		actual     interface{}
+		less       []func(i, j int) bool
+		shouldFail bool
+	}{
+		{
+			name:   "should assert sorted ints",
+			actual: []int{1, 2, 2, 3},
+		},
+		{
+			name:   "should assert sorted floats",
+			actual: []float64{-1.5, 0, 2.25},
+		},
+		{
+			name:   "should assert sorted strings",
+			actual: []string{"a", "ab", "b"},
+		},
+		{
+			name:   "should assert single element slice",
+			actual: []int{1},
+		},
+		{
+			name:   "should assert empty slice",
+			actual: []string{},
+		},
+		{
+			name:       "should fail for reverse sorted ints",
+			actual:     []int{3, 2, 1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unsorted uints",
+			actual:     []uint{1, 3, 2},
+			shouldFail: true,
+		},
+		{
+			name:   "should assert sorted structs with less function",
+			actual: people,
+			less:   []func(i, j int) bool{byAge},
+		},
+		{
+			name:       "should fail for structs without less function",
+			actual:     people,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).IsSorted(tt.less...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_IsSortedDescending(t *testing.T) {
+	people := []struct{ age int }{{age: 40}, {age: 30}, {age: 20}}
+	byAge := func(i, j int) bool { return people[i].age < people[j].age }
+	tests := []struct {
+		name       string
+		actual     interface{}
+		less       []func(i, j int) bool
+		shouldFail bool
+	}{
+		{
+			name:   "should assert reverse sorted ints",
+			actual: []int{3, 2, 2, 1},
+		},
+		{
+			name:   "should assert reverse sorted strings",
+			actual: []string{"b", "ab", "a"},
+		},
+		{
+			name:   "should assert single element slice",
+			actual: []float64{1},
+		},
+		{
+			name:       "should fail for ascending sorted ints",
+			actual:     []int{1, 2, 3},
+			shouldFail: true,
+		},
+		{
+			name:   "should assert reverse sorted structs with less function",
+			actual: people,
+			less:   []func(i, j int) bool{byAge},
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     "abc",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).IsSortedDescending(tt.less...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_EveryWindowOfSizeSatisfies(t *testing.T) {
+	notThreeIncreasing := func(window interface{}) bool {
+		w := window.([]int)
+		return !(w[0] < w[1] && w[1] < w[2])
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		size       int
+		predicate  func(window interface{}) bool
+		shouldFail bool
+	}{
+		{
+			name:      "should succeed if every window satisfies the predicate",
+			actual:    []int{1, 2, 1, 2, 1},
+			size:      3,
+			predicate: notThreeIncreasing,
+		},
+		{
+			name:       "should fail if a window doesn't satisfy the predicate",
+			actual:     []int{3, 1, 2, 3, 1},
+			size:       3,
+			predicate:  notThreeIncreasing,
+			shouldFail: true,
+		},
+		{
+			name:      "should succeed for window size equal to the slice size",
+			actual:    []int{3, 2, 1},
+			size:      3,
+			predicate: notThreeIncreasing,
+		},
+		{
+			name:       "should fail for window size greater than the slice size",
+			actual:     []int{1, 2},
+			size:       3,
+			predicate:  notThreeIncreasing,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero window size",
+			actual:     []int{1, 2},
+			size:       0,
+			predicate:  notThreeIncreasing,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     12,
+			size:       1,
+			predicate:  notThreeIncreasing,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).EveryWindowOfSizeSatisfies(tt.size, tt.predicate)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_DeepEqualTo(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should assert equal struct slices",
+			actual:   []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			expected: []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+		},
+		{
+			name:       "should fail for changed element",
+			actual:     []person{{Name: "a", Age: 1}, {Name: "c", Age: 2}},
+			expected:   []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for added element",
+			actual:     []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			expected:   []person{{Name: "a", Age: 1}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for removed element",
+			actual:     []person{{Name: "a", Age: 1}},
+			expected:   []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for different order",
+			actual:     []person{{Name: "b", Age: 2}, {Name: "a", Age: 1}},
+			expected:   []person{{Name: "a", Age: 1}, {Name: "b", Age: 2}},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).DeepEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestSumOfFieldThat(t *testing.T) {
+	type order struct {
+		ID    string
+		Total float64
+	}
+	total := func(element interface{}) float64 {
+		return element.(order).Total
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   float64
+		shouldFail bool
+	}{
+		{
+			name:     "should sum the extracted field",
+			actual:   []order{{ID: "1", Total: 10.5}, {ID: "2", Total: 4.5}, {ID: "3", Total: 5}},
+			expected: 20,
+		},
+		{
+			name:     "should yield zero for empty slice",
+			actual:   []order{},
+			expected: 0,
+		},
+		{
+			name:       "should fail if the sum is different",
+			actual:     []order{{ID: "1", Total: 10.5}},
+			expected:   10,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     order{ID: "1", Total: 10.5},
+			expected:   0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			SumOfFieldThat(test, tt.actual, total).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestZipEqualThat(t *testing.T) {
+	doubled := func(a, b interface{}) bool {
+		return a.(int)*2 == b.(int)
+	}
+	tests := []struct {
+		name       string
+		as, bs     interface{}
+		shouldFail bool
+	}{
+		{
+			name: "should succeed if every aligned pair satisfies the relation",
+			as:   []int{1, 2, 3},
+			bs:   []int{2, 4, 6},
+		},
+		{
+			name: "should succeed for empty slices",
+			as:   []int{},
+			bs:   []int{},
+		},
+		{
+			name:       "should fail if an aligned pair doesn't satisfy the relation",
+			as:         []int{1, 2, 3},
+			bs:         []int{2, 5, 6},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for slices of different sizes",
+			as:         []int{1, 2, 3},
+			bs:         []int{2, 4},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			as:         []int{1, 2, 3},
+			bs:         2,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ZipEqualThat(test, tt.as, tt.bs, doubled)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestZipEqualThat_DifferentElementTypes(t *testing.T) {
+	inputs := []string{"1", "22", "333"}
+	outputs := []int{1, 2, 3}
+	ZipEqualThat(t, inputs, outputs, func(a, b interface{}) bool {
+		return len(a.(string)) == b.(int)
+	})
+}
+
+func TestPartitionThat(t *testing.T) {
+	type result struct {
+		ID  int
+		Err string
+	}
+	succeeded := func(element interface{}) bool {
+		return element.(result).Err == ""
+	}
+	tests := []struct {
+		name        string
+		actual      interface{}
+		matching    interface{}
+		nonMatching interface{}
+		shouldFail  bool
+	}{
+		{
+			name:        "should partition slice preserving order",
+			actual:      []result{{ID: 1}, {ID: 2, Err: "timeout"}, {ID: 3}, {ID: 4, Err: "refused"}},
+			matching:    []result{{ID: 1}, {ID: 3}},
+			nonMatching: []result{{ID: 2, Err: "timeout"}, {ID: 4, Err: "refused"}},
+		},
+		{
+			name:        "should partition slice with an empty partition",
+			actual:      []result{{ID: 1}, {ID: 2}},
+			matching:    []result{{ID: 1}, {ID: 2}},
+			nonMatching: []result{},
+		},
+		{
+			name:        "should fail if partitions are different",
+			actual:      []result{{ID: 1}, {ID: 2, Err: "timeout"}},
+			matching:    []result{{ID: 1}, {ID: 2, Err: "timeout"}},
+			nonMatching: []result{},
+			shouldFail:  true,
+		},
+		{
+			name:        "should fail for a non-slice value",
+			actual:      result{ID: 1},
+			matching:    []interface{}{},
+			nonMatching: []interface{}{},
+			shouldFail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			matching, nonMatching := PartitionThat(test, tt.actual, succeeded)
+			matching.IsEqualTo(tt.matching)
+			nonMatching.IsEqualTo(tt.nonMatching)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestFlatMapThat(t *testing.T) {
+	type order struct {
+		ID        int
+		LineItems []string
+	}
+	lineItems := func(element interface{}) interface{} {
+		return element.(order).LineItems
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		fn         func(element interface{}) interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should flatten the mapped slices",
+			actual:   []order{{ID: 1, LineItems: []string{"a", "b"}}, {ID: 2}, {ID: 3, LineItems: []string{"c"}}},
+			fn:       lineItems,
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "should flatten empty slice",
+			actual:   []order{},
+			fn:       lineItems,
+			expected: []interface{}{},
+		},
+		{
+			name:       "should fail if flattened slice is different",
+			actual:     []order{{ID: 1, LineItems: []string{"a", "b"}}},
+			fn:         lineItems,
+			expected:   []string{"a"},
+			shouldFail: true,
+		},
+		{
+			name:   "should fail if function doesn't return slices",
+			actual: []order{{ID: 1}},
+			fn: func(element interface{}) interface{} {
+				return element.(order).ID
+			},
+			expected:   []interface{}{},
+			shouldFail: true,
+		},
+		{
+			name:   "should fail if function returns slices of different types",
+			actual: []int{1, 2},
+			fn: func(element interface{}) interface{} {
+				if element.(int) == 1 {
+					return []int{1}
+				}
+				return []string{"2"}
+			},
+			expected:   []interface{}{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     order{ID: 1},
+			fn:         lineItems,
+			expected:   []interface{}{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			FlatMapThat(test, tt.actual, tt.fn).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_IntersectionWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		other      interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should return common elements in actual's order",
+			actual:   []string{"read", "write", "delete"},
+			other:    []string{"delete", "admin", "read"},
+			expected: []string{"read", "delete"},
+		},
+		{
+			name:     "should return distinct common elements",
+			actual:   []int{1, 2, 1, 3},
+			other:    []int{1, 3},
+			expected: []int{1, 3},
+		},
+		{
+			name:     "should return empty intersection for disjoint slices",
+			actual:   []int{1, 2},
+			other:    []int{3, 4},
+			expected: []int{},
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     []int{1, 2},
+			other:      1,
+			expected:   []int{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).IntersectionWith(tt.other).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_DifferenceFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		other      interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should return missing elements in actual's order",
+			actual:   []string{"read", "write", "delete"},
+			other:    []string{"delete", "admin"},
+			expected: []string{"read", "write"},
+		},
+		{
+			name:     "should return distinct missing elements",
+			actual:   []int{1, 2, 1, 3},
+			other:    []int{3},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "should return empty difference for a subset",
+			actual:   []int{1, 2},
+			other:    []int{2, 1, 3},
+			expected: []int{},
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     "abc",
+			other:      []int{1},
+			expected:   []int{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).DifferenceFrom(tt.other).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_MaxBy(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	byAge := func(a, b interface{}) bool {
+		return a.(user).Age < b.(user).Age
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should return the greatest element",
+			actual:   []user{{"alice", 30}, {"bob", 45}, {"carol", 21}},
+			expected: user{"bob", 45},
+		},
+		{
+			name:     "should return the first of many greatest elements",
+			actual:   []user{{"alice", 45}, {"bob", 45}},
+			expected: user{"alice", 45},
+		},
+		{
+			name:       "should fail if the greatest element is not the expected one",
+			actual:     []user{{"alice", 30}, {"bob", 45}},
+			expected:   user{"alice", 30},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for an empty slice",
+			actual:     []user{},
+			expected:   nil,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     user{"alice", 30},
+			expected:   nil,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).MaxBy(byAge).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_MinBy(t *testing.T) {
+	byLength := func(a, b interface{}) bool {
+		return len(a.(string)) < len(b.(string))
+	}
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should return the least element",
+			actual:   []string{"abc", "a", "ab"},
+			expected: "a",
+		},
+		{
+			name:     "should return the first of many least elements",
+			actual:   [3]string{"ab", "cd", "abc"},
+			expected: "ab",
+		},
+		{
+			name:       "should fail if the least element is not the expected one",
+			actual:     []string{"abc", "a"},
+			expected:   "abc",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for an empty slice",
+			actual:     []string{},
+			expected:   nil,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).MinBy(byLength).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_ContainsExactly_OrderMessage(t *testing.T) {
+	test := &recordingT{}
+	thatSlice(assertion{t: test}, []int{1, 3, 2}).ContainsExactly(1, 2, 3)
+	ThatSlice(t, test.errors).HasSize(1)
+	ThatString(t, test.errors[0]).Contains("element at index 1 is 3 instead of 2")
+}
+
+func TestIndexByThat(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	byID := func(element interface{}) interface{} {
+		return element.(user).ID
+	}
+	tests := []struct {
+		name       string
+		slice      interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should index elements by key",
+			slice:    []user{{1, "alice"}, {2, "bob"}},
+			expected: map[int]user{1: {1, "alice"}, 2: {2, "bob"}},
+		},
+		{
+			name:     "should index empty slice",
+			slice:    []user{},
+			expected: map[interface{}]user{},
+		},
+		{
+			name:       "should fail for duplicate keys",
+			slice:      []user{{1, "alice"}, {1, "bob"}},
+			expected:   map[int]user{1: {1, "alice"}},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			slice:      user{1, "alice"},
+			expected:   map[int]user{1: {1, "alice"}},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			IndexByThat(test, tt.slice, byID).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestIndexByThat_KeyTypes(t *testing.T) {
+	tests := []struct {
+		name       string
+		slice      interface{}
+		keyFn      func(element interface{}) interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:  "should index by nil key",
+			slice: []int{1, 2},
+			keyFn: func(element interface{}) interface{} {
+				if element.(int) == 1 {
+					return nil
+				}
+				return element
+			},
+			expected: map[interface{}]int{nil: 1, 2: 2},
+		},
+		{
+			name:  "should index by keys of mixed types",
+			slice: []int{1, 2},
+			keyFn: func(element interface{}) interface{} {
+				if element.(int) == 1 {
+					return "one"
+				}
+				return element
+			},
+			expected: map[interface{}]int{"one": 1, 2: 2},
+		},
+		{
+			name:       "should fail for non comparable slice key",
+			slice:      []int{1, 2},
+			keyFn:      func(element interface{}) interface{} { return []int{element.(int)} },
+			expected:   map[interface{}]int{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for non comparable map key",
+			slice:      []int{1},
+			keyFn:      func(element interface{}) interface{} { return map[int]int{} },
+			expected:   map[interface{}]interface{}{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for duplicate nil keys",
+			slice:      []int{1, 2},
+			keyFn:      func(element interface{}) interface{} { return nil },
+			expected:   map[interface{}]interface{}{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			IndexByThat(test, tt.slice, tt.keyFn).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestIndexByThat_HasKey(t *testing.T) {
+	test := &testing.T{}
+	IndexByThat(test, []string{"apple", "banana"}, func(element interface{}) interface{} {
+		return element.(string)[0]
+	}).HasKey(byte('b')).HasNotKey(byte('c'))
+	ThatBool(t, test.Failed()).IsFalse()
+}
+
+func TestAssertableSlice_ContainsAllOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		other      interface{}
+		shouldFail bool
+	}{
+		{
+			name:   "should assert slice containing all elements",
+			actual: []string{"read", "write", "delete"},
+			other:  []string{"delete", "read"},
+		},
+		{
+			name:   "should assert empty other slice",
+			actual: []string{"read"},
+			other:  []string{},
+		},
+		{
+			name:       "should fail if any element is missing",
+			actual:     []string{"read", "write"},
+			other:      []string{"read", "admin", "delete"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice other value",
+			actual:     []string{"read"},
+			other:      "read",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).ContainsAllOf(tt.other)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_ContainsAnyOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		other      interface{}
+		shouldFail bool
+	}{
+		{
+			name:   "should assert slice containing some element",
+			actual: []int{1, 2, 3},
+			other:  []int{5, 3},
+		},
+		{
+			name:       "should fail if no element is contained",
+			actual:     []int{1, 2, 3},
+			other:      []int{4, 5},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for empty other slice",
+			actual:     []int{1, 2, 3},
+			other:      []int{},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     1,
+			other:      []int{1},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).ContainsAnyOf(tt.other)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_IsEqualToIgnoringNils(t *testing.T) {
+	one, two := 1, 2
+	tests := []struct {
+		name       string
+		actual     interface{}
+		expected   interface{}
+		shouldFail bool
+	}{
+		{
+			name:     "should ignore nil pointers",
+			actual:   []*int{nil, &one, nil, &two},
+			expected: []*int{&one, &two, nil},
+		},
+		{
+			name:     "should ignore nil interfaces",
+			actual:   []interface{}{"a", nil, 1},
+			expected: []interface{}{"a", 1},
+		},
+		{
+			name:       "should fail for different pointed values",
+			actual:     []*int{nil, &one},
+			expected:   []*int{&two},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for different order",
+			actual:     []interface{}{1, nil, "a"},
+			expected:   []interface{}{"a", 1},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     []interface{}{1},
+			expected:   1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual).IsEqualToIgnoringNils(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_IsContiguousSequence(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		sliceOpts  []SliceOpt
+		shouldFail bool
+	}{
+		{
+			name:   "should assert contiguous sequence",
+			actual: []int{3, 4, 5, 6},
+		},
+		{
+			name:   "should assert contiguous sequence of negative int64",
+			actual: []int64{-2, -1, 0, 1},
+		},
+		{
+			name:   "should assert empty slice",
+			actual: []int{},
+		},
+		{
+			name:       "should fail for sequence with a gap",
+			actual:     []int{1, 2, 4, 5},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unsorted sequence",
+			actual:     []int{3, 1, 2},
+			shouldFail: true,
+		},
+		{
+			name:      "should assert unsorted sequence when sorting",
+			actual:    []int{3, 1, 2},
+			sliceOpts: []SliceOpt{SortingSequences()},
+		},
+		{
+			name:       "should fail for duplicate elements",
+			actual:     []int{1, 1, 2},
+			sliceOpts:  []SliceOpt{SortingSequences()},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-int slice",
+			actual:     []string{"1", "2"},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual, tt.sliceOpts...).IsContiguousSequence()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_HasNoGaps(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		step       int
+		sliceOpts  []SliceOpt
+		shouldFail bool
+	}{
+		{
+			name:   "should assert pagination offsets",
+			actual: []int{0, 20, 40, 60},
+			step:   20,
+		},
+		{
+			name:      "should assert unsorted progression when sorting",
+			actual:    []int32{40, 0, 20},
+			step:      20,
+			sliceOpts: []SliceOpt{SortingSequences()},
+		},
+		{
+			name:       "should fail for progression with a gap",
+			actual:     []int{0, 20, 60},
+			step:       20,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unsorted progression",
+			actual:     []int{0, 20, 10},
+			step:       10,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for duplicate element",
+			actual:     []int{0, 10, 10, 20},
+			step:       10,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non-slice value",
+			actual:     20,
+			step:       20,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatSlice(test, tt.actual, tt.sliceOpts...).HasNoGaps(tt.step)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableSlice_HasNoGaps_Messages(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   []int
+		expected string
+	}{
+		{
+			name:     "should report missing value of a gap",
+			actual:   []int{0, 10, 30},
+			expected: shouldHaveNoGaps(thatSlice(assertion{}, []int{0, 10, 30}).actual, 20, 30),
+		},
+		{
+			name:     "should report out of order element",
+			actual:   []int{0, 20, 10},
+			expected: shouldHaveNextInSequence(thatSlice(assertion{}, []int{0, 20, 10}).actual, 10, 0, 20, 1),
+		},
+		{
+			name:     "should report duplicate element",
+			actual:   []int{0, 10, 10, 20},
+			expected: shouldHaveNextInSequence(thatSlice(assertion{}, []int{0, 10, 10, 20}).actual, 20, 10, 10, 2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &recordingT{}
+			thatSlice(assertion{t: test}, tt.actual).HasNoGaps(10)
+			ThatSlice(t, test.errors).IsEqualTo([]string{tt.expected})
+		})
+	}
+}
+
+func TestThatSlice_NonSliceValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		assert     func(a AssertableSlice)
+		shouldFail bool
+	}{
+		{name: "HasSize", assert: func(a AssertableSlice) { a.HasSize(1) }, shouldFail: true},
+		{name: "IsNotEmpty", assert: func(a AssertableSlice) { a.IsNotEmpty() }, shouldFail: true},
+		{name: "Contains", assert: func(a AssertableSlice) { a.Contains(12) }, shouldFail: true},
+		{name: "IsEmpty", assert: func(a AssertableSlice) { a.IsEmpty() }},
+		{name: "DoesNotContain", assert: func(a AssertableSlice) { a.DoesNotContain(12) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			tt.assert(ThatSlice(test, 12))
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package assert
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// SoftAssertions collects the failures of the assertions created by it instead of erroring the test immediately, so
+// all the failures of a test can be seen at once. The collected failures are reported by AssertAll.
+type SoftAssertions struct {
+	t        *testing.T
+	mu       sync.Mutex
+	failures []string
+}
+
+// NewSoftAssertions creates a new SoftAssertions collecting the failures to be reported to the given test reference.
+func NewSoftAssertions(t *testing.T) *SoftAssertions {
+	return &SoftAssertions{
+		t: t,
+	}
+}
+
+// Error records a failure with the given message.
+func (s *SoftAssertions) Error(args ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, fmt.Sprint(args...))
+}
+
+// Errorf records a failure with the given formatted message.
+func (s *SoftAssertions) Errorf(format string, args ...interface{}) {
+	s.Error(fmt.Sprintf(format, args...))
+}
+
+// AssertAll errors the test with the message of every failure collected so far, in the order they occurred, and
+// clears them. It doesn't error the test if no assertion failed.
+func (s *SoftAssertions) AssertAll() {
+	s.t.Helper()
+	s.mu.Lock()
+	failures := s.failures
+	s.failures = nil
+	s.mu.Unlock()
+	for _, failure := range failures {
+		s.t.Error(failure)
+	}
+}
+
+// That initializes an assertable object collecting its failures to be used for asserting properties of any type.
+func (s *SoftAssertions) That(actual interface{}) AssertableAny {
+	return that(assertion{t: s}, actual)
+}
+
+// ThatBool initializes an assertable bool collecting its failures.
+func (s *SoftAssertions) ThatBool(actual bool) AssertableBool {
+	return thatBool(assertion{t: s}, actual)
+}
+
+// ThatChannel initializes an assertable channel collecting its failures.
+func (s *SoftAssertions) ThatChannel(ch interface{}, opts ...ChannelOpt) AssertableChannel {
+	return thatChannel(assertion{t: s}, ch, opts...)
+}
+
+// ThatDuration initializes an assertable time.Duration collecting its failures.
+func (s *SoftAssertions) ThatDuration(actual time.Duration) AssertableDuration {
+	return thatDuration(assertion{t: s}, actual)
+}
+
+// ThatError initializes an assertable error collecting its failures.
+func (s *SoftAssertions) ThatError(actual error) AssertableError {
+	return thatError(assertion{t: s}, actual)
+}
+
+// ThatFloat64 initializes an assertable float64 collecting its failures.
+func (s *SoftAssertions) ThatFloat64(actual float64, opts ...FloatOpt) AssertableFloat64 {
+	return thatFloat64(assertion{t: s}, actual, opts...)
+}
+
+// ThatInt initializes an assertable int collecting its failures.
+func (s *SoftAssertions) ThatInt(actual int) AssertableInt {
+	return thatInt(assertion{t: s}, actual)
+}
+
+// ThatMap initializes an assertable map collecting its failures.
+func (s *SoftAssertions) ThatMap(actual interface{}) AssertableMap {
+	return thatMap(assertion{t: s}, actual)
+}
+
+// ThatPanics calls the given function and initializes an assertable panic outcome collecting its failures.
+func (s *SoftAssertions) ThatPanics(fn func()) AssertablePanic {
+	return thatPanics(assertion{t: s}, fn)
+}
+
+// ThatSlice initializes an assertable slice collecting its failures.
+func (s *SoftAssertions) ThatSlice(actual interface{}, opts ...SliceOpt) AssertableSlice {
+	return thatSlice(assertion{t: s}, actual, opts...)
+}
+
+// ThatString initializes an assertable string collecting its failures.
+func (s *SoftAssertions) ThatString(actual string, opts ...StringOpt) AssertableString {
+	return thatString(assertion{t: s}, actual, opts...)
+}
+
+// ThatStruct initializes an assertable struct collecting its failures.
+func (s *SoftAssertions) ThatStruct(actual interface{}) AssertableStruct {
+	return thatStruct(assertion{t: s}, actual)
+}
+
+// ThatTime initializes an assertable time.Time collecting its failures.
+func (s *SoftAssertions) ThatTime(actual time.Time, opts ...TimeOpt) AssertableTime {
+	return thatTime(assertion{t: s}, actual, opts...)
+}
@@ -0,0 +1,63 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
+)
+
+func TestSoftAssertions(t *testing.T) {
+	tests := []struct {
+		name             string
+		assert           func(soft *SoftAssertions)
+		expectedFailures []string
+	}{
+		{
+			name: "should collect every failure",
+			assert: func(soft *SoftAssertions) {
+				soft.ThatString("a").IsEqualTo("b")
+				soft.ThatInt(1).IsEqualTo(1)
+				soft.ThatTime(time.Time{}).IsDefined()
+				soft.ThatError(errors.New("boom")).IsNil()
+			},
+			expectedFailures: []string{
+				shouldBeEqual(thatString(assertion{}, "a").actual, "b"),
+				shouldBeDefined(thatTime(assertion{}, time.Time{}).actual),
+				shouldBeNil(values.NewAnyValue(errors.New("boom"))),
+			},
+		},
+		{
+			name: "should keep custom messages",
+			assert: func(soft *SoftAssertions) {
+				soft.ThatSlice([]int{1}).WithMessage("ids").IsEmpty()
+				soft.ThatBool(false).WithMessage("enabled").IsTrue()
+			},
+			expectedFailures: []string{
+				"ids: " + shouldBeEmpty(thatSlice(assertion{}, []int{1}).actual),
+				"enabled: " + shouldBeEqual(thatBool(assertion{}, false).actual, true),
+			},
+		},
+		{
+			name: "should collect nothing if all assertions pass",
+			assert: func(soft *SoftAssertions) {
+				soft.ThatString("a").IsEqualTo("a")
+				soft.That(1).IsNotNil()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			soft := NewSoftAssertions(test)
+			tt.assert(soft)
+			ThatBool(t, test.Failed()).IsFalse()
+			ThatSlice(t, soft.failures).IsEqualTo(tt.expectedFailures)
+
+			soft.AssertAll()
+			ThatBool(t, test.Failed()).IsEqualTo(len(tt.expectedFailures) > 0)
+			ThatSlice(t, soft.failures).IsEmpty()
+		})
+	}
+}
@@ -1,9 +1,23 @@
 package assert
 
 import (
+	"crypto"
+	_ "crypto/sha1"   // nolint:gosec // registers SHA-1 to be selectable for snapshot hashes, not used for security
+	_ "crypto/sha256" // registers SHA-256 which is the default hash of snapshot hashes
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
+	"unicode"
 
+	"github.com/ppapapetrou76/go-testing/internal/pkg/utils"
 	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
 )
 
@@ -12,8 +26,13 @@ type StringOpt func(*AssertableString)
 
 // AssertableString is the implementation of CommonAssertable for string types.
 type AssertableString struct {
-	t      *testing.T
-	actual values.StringValue
+	assertion
+	actual               values.StringValue
+	base32Encoding       *base32.Encoding
+	tabWidth             int
+	hash                 crypto.Hash
+	cronSeconds          bool
+	hexColorHashOptional bool
 }
 
 // IgnoringCase sets underlying value to lower case.
@@ -30,12 +49,105 @@ func IgnoringWhiteSpaces() StringOpt {
 	}
 }
 
+// IgnoringPunctuation removes the punctuation characters from the value under assertion.
+func IgnoringPunctuation() StringOpt {
+	return func(c *AssertableString) {
+		c.actual = c.actual.AddDecorator(values.RemovePunctuation)
+	}
+}
+
+// NormalizingLineEndings converts the \r\n and \r line endings of the value under assertion to \n, so texts with
+// different line endings can be compared. The size of the value under assertion is the size of the normalized value.
+func NormalizingLineEndings() StringOpt {
+	return func(c *AssertableString) {
+		c.actual = c.actual.AddDecorator(values.NormalizeLineEndings)
+	}
+}
+
+// WithTransform transforms the value under assertion with the given function before asserting it, for example to
+// strip ANSI color codes. Transforms, including the ones of other options such as IgnoringCase, are applied in the
+// order they are given. Assertions comparing with an expected string, such as IsEqualTo or Contains, apply the
+// transforms to the expected string too.
+func WithTransform(fn func(string) string) StringOpt {
+	return func(c *AssertableString) {
+		c.actual = c.actual.AddDecorator(fn)
+	}
+}
+
+// CountingRunes sets size and length assertions to count the runes of the value under assertion instead of its bytes,
+// so a multi-byte character such as 'é' counts once.
+func CountingRunes() StringOpt {
+	return func(c *AssertableString) {
+		c.actual = c.actual.CountingRunes()
+	}
+}
+
+// UsingBase32HexEncoding sets the "Extended Hex Alphabet" defined in RFC 4648 to be used by base32 assertions
+// instead of the standard one.
+func UsingBase32HexEncoding() StringOpt {
+	return func(c *AssertableString) {
+		c.base32Encoding = base32.HexEncoding
+	}
+}
+
+// WithTabWidth sets the number of leading spaces a tab character counts for in indentation assertions
+// By default a tab counts as a single leading whitespace character.
+func WithTabWidth(width int) StringOpt {
+	return func(c *AssertableString) {
+		c.tabWidth = width
+	}
+}
+
+// HashingWith sets the hash function used by hash assertions. The crypto.SHA1 and crypto.SHA256 functions are always
+// available and the latter is used by default.
+func HashingWith(hash crypto.Hash) StringOpt {
+	return func(c *AssertableString) {
+		c.hash = hash
+	}
+}
+
+// WithCronSeconds sets cron expression assertions to expect six fields, the first one being the seconds, instead of
+// the standard five.
+func WithCronSeconds() StringOpt {
+	return func(c *AssertableString) {
+		c.cronSeconds = true
+	}
+}
+
+// WithOptionalHexColorHash sets hex color assertions to accept colors without the leading #.
+func WithOptionalHexColorHash() StringOpt {
+	return func(c *AssertableString) {
+		c.hexColorHashOptional = true
+	}
+}
+
+// creditCardPattern is the regular expression of credit card numbers. Its matches are only reported if they pass the
+// Luhn checksum, so other long numbers such as timestamps are not mistaken for card numbers.
+const creditCardPattern = `\b\d{4}[- ]?\d{4}[- ]?\d{4}[- ]?\d{1,4}\b`
+
+// PIIPatterns are the regular expressions of common personally identifiable information that IsRedacted always checks:
+// credit card numbers passing the Luhn checksum, US social security numbers and email addresses.
+var PIIPatterns = []string{
+	creditCardPattern,
+	`\b\d{3}-\d{2}-\d{4}\b`,
+	`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`,
+}
+
+var markdownLinkPattern = regexp.MustCompile(`\[([^\]]*)\]\(([^)]*)\)`)
+
 // ThatString returns an AssertableString structure initialized with the test reference and the actual value to assert.
 func ThatString(t *testing.T, actual string, opts ...StringOpt) AssertableString {
 	t.Helper()
+	return thatString(assertion{t: t}, actual, opts...)
+}
+
+func thatString(a assertion, actual string, opts ...StringOpt) AssertableString {
 	assertable := &AssertableString{
-		t:      t,
-		actual: values.NewStringValue(actual),
+		assertion:      a,
+		actual:         values.NewStringValue(actual),
+		base32Encoding: base32.StdEncoding,
+		tabWidth:       1,
+		hash:           crypto.SHA256,
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -49,7 +161,7 @@ func ThatString(t *testing.T, actual string, opts ...StringOpt) AssertableString
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableString) IsEqualTo(expected interface{}) AssertableString {
 	if !a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -58,7 +170,33 @@ func (a AssertableString) IsEqualTo(expected interface{}) AssertableString {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableString) IsNotEqualTo(expected interface{}) AssertableString {
 	if a.actual.IsEqualTo(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsOneOf asserts if the assertable string is equal to any of the given candidates. The options of the assertable
+// string, such as IgnoringCase, apply to the candidates too.
+// It errors the tests if the string is equal to none of the candidates, so it always errors without candidates.
+func (a AssertableString) IsOneOf(candidates ...string) AssertableString {
+	for _, candidate := range candidates {
+		if a.actual.IsEqualTo(candidate) {
+			return a
+		}
+	}
+	a.error(shouldBeOneOf(a.actual, candidates))
+	return a
+}
+
+// IsNoneOf asserts if the assertable string is equal to none of the given candidates. The options of the assertable
+// string, such as IgnoringCase, apply to the candidates too.
+// It errors the tests if the string is equal to any of the candidates.
+func (a AssertableString) IsNoneOf(candidates ...string) AssertableString {
+	for _, candidate := range candidates {
+		if a.actual.IsEqualTo(candidate) {
+			a.error(shouldBeNoneOf(a.actual, candidates, candidate))
+			return a
+		}
 	}
 	return a
 }
@@ -67,25 +205,27 @@ func (a AssertableString) IsNotEqualTo(expected interface{}) AssertableString {
 // It errors the tests if the string is not empty.
 func (a AssertableString) IsEmpty() AssertableString {
 	if a.actual.IsNotEmpty() {
-		a.t.Error(shouldBeEmpty(a.actual))
+		a.error(shouldBeEmpty(a.actual))
 	}
 	return a
 }
 
-// IsLowerCase asserts if the expected string is lower case
+// IsLowerCase asserts if the expected string is lower case, that is equal to its strings.ToLower form. Characters
+// without case, such as digits and punctuation, are ignored, so a string without any cased character is lower case.
 // It errors the tests if the string is not lower case.
 func (a AssertableString) IsLowerCase() AssertableString {
 	if !a.actual.IsLowerCase() {
-		a.t.Error(shouldBeLowerCase(a.actual))
+		a.error(shouldBeLowerCase(a.actual))
 	}
 	return a
 }
 
-// IsUpperCase asserts if the expected string is upper case
+// IsUpperCase asserts if the expected string is upper case, that is equal to its strings.ToUpper form. Characters
+// without case, such as digits and punctuation, are ignored, so a string without any cased character is upper case.
 // It errors the tests if the string is not upper case.
 func (a AssertableString) IsUpperCase() AssertableString {
 	if !a.actual.IsUpperCase() {
-		a.t.Error(shouldBeUpperCase(a.actual))
+		a.error(shouldBeUpperCase(a.actual))
 	}
 	return a
 }
@@ -94,7 +234,25 @@ func (a AssertableString) IsUpperCase() AssertableString {
 // It errors the tests if the string is empty.
 func (a AssertableString) IsNotEmpty() AssertableString {
 	if a.actual.IsEmpty() {
-		a.t.Error(shouldNotBeEmpty(a.actual))
+		a.error(shouldNotBeEmpty(a.actual))
+	}
+	return a
+}
+
+// IsBlank asserts if the expected string is empty or contains only white spaces, as defined by strings.TrimSpace
+// It errors the tests if the string contains any other character.
+func (a AssertableString) IsBlank() AssertableString {
+	if !a.actual.IsBlank() {
+		a.error(shouldBeBlank(a.actual))
+	}
+	return a
+}
+
+// IsNotBlank asserts if the expected string contains any character other than white spaces
+// It errors the tests if the string is empty or contains only white spaces.
+func (a AssertableString) IsNotBlank() AssertableString {
+	if a.actual.IsBlank() {
+		a.error(shouldNotBeBlank(a.actual))
 	}
 	return a
 }
@@ -103,16 +261,45 @@ func (a AssertableString) IsNotEmpty() AssertableString {
 // It errors the test if it does not contain it.
 func (a AssertableString) Contains(substring string) AssertableString {
 	if a.actual.DoesNotContain(substring) {
-		a.t.Error(shouldContain(a.actual, substring))
+		a.error(shouldContain(a.actual, substring))
+	}
+	return a
+}
+
+// ContainsAll asserts if the assertable string contains every one of the given substrings. The options of the
+// assertable string, such as IgnoringCase, apply to the substrings too.
+// It errors the test if any of the substrings is missing, reporting the missing ones.
+func (a AssertableString) ContainsAll(substrings ...string) AssertableString {
+	var missing []string
+	for _, substring := range substrings {
+		if !a.actual.Contains(substring) {
+			missing = append(missing, substring)
+		}
+	}
+	if len(missing) > 0 {
+		a.error(shouldContain(a.actual, missing))
 	}
 	return a
 }
 
+// ContainsAny asserts if the assertable string contains at least one of the given substrings. The options of the
+// assertable string, such as IgnoringCase, apply to the substrings too.
+// It errors the test if it contains none of the substrings, which is always the case without substrings.
+func (a AssertableString) ContainsAny(substrings ...string) AssertableString {
+	for _, substring := range substrings {
+		if a.actual.Contains(substring) {
+			return a
+		}
+	}
+	a.error(shouldContainAnyOf(a.actual, substrings))
+	return a
+}
+
 // ContainsIgnoringCase asserts if the assertable string contains the given element(s) case insensitively
 // It errors the test if it does not contain it.
 func (a AssertableString) ContainsIgnoringCase(substring string) AssertableString {
 	if !a.actual.ContainsIgnoringCase(substring) {
-		a.t.Error(shouldContainIgnoringCase(a.actual, substring))
+		a.error(shouldContainIgnoringCase(a.actual, substring))
 	}
 	return a
 }
@@ -121,7 +308,7 @@ func (a AssertableString) ContainsIgnoringCase(substring string) AssertableStrin
 // It errors the test if it does not contain it.
 func (a AssertableString) ContainsOnly(substring string) AssertableString {
 	if !a.actual.ContainsOnly(substring) {
-		a.t.Error(shouldContainOnly(a.actual, substring))
+		a.error(shouldContainOnly(a.actual, substring))
 	}
 	return a
 }
@@ -130,7 +317,7 @@ func (a AssertableString) ContainsOnly(substring string) AssertableString {
 // It errors the test if it does not contain it or contains more than once.
 func (a AssertableString) ContainsOnlyOnce(substring string) AssertableString {
 	if !a.actual.ContainsOnlyOnce(substring) {
-		a.t.Error(shouldContainOnlyOnce(a.actual, substring))
+		a.error(shouldContainOnlyOnce(a.actual, substring))
 	}
 	return a
 }
@@ -139,7 +326,7 @@ func (a AssertableString) ContainsOnlyOnce(substring string) AssertableString {
 // It errors the test if it does not contain any.
 func (a AssertableString) ContainsWhitespaces() AssertableString {
 	if !a.actual.ContainsWhitespaces() {
-		a.t.Error(shouldContainWhiteSpace(a.actual))
+		a.error(shouldContainWhiteSpace(a.actual))
 	}
 	return a
 }
@@ -148,7 +335,7 @@ func (a AssertableString) ContainsWhitespaces() AssertableString {
 // It errors the test if it does contain any.
 func (a AssertableString) DoesNotContainAnyWhitespaces() AssertableString {
 	if a.actual.ContainsWhitespaces() {
-		a.t.Error(shouldNotContainAnyWhiteSpace(a.actual))
+		a.error(shouldNotContainAnyWhiteSpace(a.actual))
 	}
 	return a
 }
@@ -157,7 +344,7 @@ func (a AssertableString) DoesNotContainAnyWhitespaces() AssertableString {
 // It errors the test if it contains it.
 func (a AssertableString) DoesNotContain(substring string) AssertableString {
 	if a.actual.Contains(substring) {
-		a.t.Error(shouldNotContain(a.actual, substring))
+		a.error(shouldNotContain(a.actual, substring))
 	}
 	return a
 }
@@ -166,7 +353,7 @@ func (a AssertableString) DoesNotContain(substring string) AssertableString {
 // It errors the test if it doesn't start with the given substring.
 func (a AssertableString) StartsWith(substring string) AssertableString {
 	if !a.actual.StartsWith(substring) {
-		a.t.Error(shouldStartWith(a.actual, substring))
+		a.error(shouldStartWith(a.actual, substring))
 	}
 	return a
 }
@@ -175,7 +362,7 @@ func (a AssertableString) StartsWith(substring string) AssertableString {
 // It errors the test if it starts with the given substring.
 func (a AssertableString) DoesNotStartWith(substring string) AssertableString {
 	if a.actual.StartsWith(substring) {
-		a.t.Error(shouldNotStartWith(a.actual, substring))
+		a.error(shouldNotStartWith(a.actual, substring))
 	}
 	return a
 }
@@ -184,7 +371,7 @@ func (a AssertableString) DoesNotStartWith(substring string) AssertableString {
 // It errors the test if it doesn't end with the given substring.
 func (a AssertableString) EndsWith(substring string) AssertableString {
 	if !a.actual.EndsWith(substring) {
-		a.t.Error(shouldEndWith(a.actual, substring))
+		a.error(shouldEndWith(a.actual, substring))
 	}
 	return a
 }
@@ -193,16 +380,73 @@ func (a AssertableString) EndsWith(substring string) AssertableString {
 // It errors the test if it end with the given substring.
 func (a AssertableString) DoesNotEndWith(substring string) AssertableString {
 	if a.actual.EndsWith(substring) {
-		a.t.Error(shouldNotEndWith(a.actual, substring))
+		a.error(shouldNotEndWith(a.actual, substring))
+	}
+	return a
+}
+
+// HasPrefixOneOf asserts if the assertable string starts with any of the given prefixes. The options of the
+// assertable string, such as IgnoringCase, apply to the prefixes too.
+// It errors the test if it starts with none of the prefixes, so it always errors without prefixes.
+func (a AssertableString) HasPrefixOneOf(prefixes ...string) AssertableString {
+	for _, prefix := range prefixes {
+		if a.actual.StartsWith(prefix) {
+			return a
+		}
+	}
+	a.error(shouldHaveAffixOneOf(a.actual, "start", prefixes))
+	return a
+}
+
+// HasSuffixOneOf asserts if the assertable string ends with any of the given suffixes. The options of the assertable
+// string, such as IgnoringCase, apply to the suffixes too.
+// It errors the test if it ends with none of the suffixes, so it always errors without suffixes.
+func (a AssertableString) HasSuffixOneOf(suffixes ...string) AssertableString {
+	for _, suffix := range suffixes {
+		if a.actual.EndsWith(suffix) {
+			return a
+		}
 	}
+	a.error(shouldHaveAffixOneOf(a.actual, "end", suffixes))
 	return a
 }
 
-// HasSameSizeAs asserts if the assertable string has the same size with the given string
+// HasSameSizeAs asserts if the assertable string has the same size with the given string. The sizes are the numbers
+// of bytes of the strings, or the numbers of their runes if CountingRunes is set.
 // It errors the test if they don't have the same size.
 func (a AssertableString) HasSameSizeAs(substring string) AssertableString {
-	if !(a.actual.Size() == len(substring)) {
-		a.t.Error(shouldHaveSameSizeAs(a.actual, substring))
+	if !(a.actual.Size() == a.actual.SizeOf(substring)) {
+		a.error(shouldHaveSameSizeAs(a.actual, substring))
+	}
+	return a
+}
+
+// HasLength asserts if the assertable string has the given length. The length is the number of bytes of the string,
+// so a multi-byte character such as 'é' counts more than once, unless CountingRunes is set.
+// It errors the test if the string doesn't have the given length.
+func (a AssertableString) HasLength(length int) AssertableString {
+	if !a.actual.HasSize(length) {
+		a.error(shouldHaveLength(a.actual, length))
+	}
+	return a
+}
+
+// IsLongerThan asserts if the assertable string is longer than the given length. The length is the number of bytes of
+// the string, so a multi-byte character such as 'é' counts more than once, unless CountingRunes is set.
+// It errors the test if the string length is less than or equal to the given length.
+func (a AssertableString) IsLongerThan(length int) AssertableString {
+	if a.actual.Size() <= length {
+		a.error(shouldBeLonger(a.actual, length))
+	}
+	return a
+}
+
+// IsShorterThan asserts if the assertable string is shorter than the given length. The length is the number of bytes
+// of the string, so a multi-byte character such as 'é' counts more than once, unless CountingRunes is set.
+// It errors the test if the string length is greater than or equal to the given length.
+func (a AssertableString) IsShorterThan(length int) AssertableString {
+	if a.actual.Size() >= length {
+		a.error(shouldBeShorter(a.actual, length))
 	}
 	return a
 }
@@ -211,7 +455,609 @@ func (a AssertableString) HasSameSizeAs(substring string) AssertableString {
 // It errors the tests if the string has other characters than digits.
 func (a AssertableString) ContainsOnlyDigits() AssertableString {
 	if !(a.actual.HasDigitsOnly()) {
-		a.t.Error(shouldContainOnlyDigits(a.actual))
+		a.error(shouldContainOnlyDigits(a.actual))
+	}
+	return a
+}
+
+// IsValidMACAddress asserts if the assertable string is a valid 6-byte IEEE 802 MAC address
+// It errors the test if the string can't be parsed as a colon or hyphen separated MAC address, so the dotted form and
+// 8-byte or 20-byte addresses accepted by net.ParseMAC are rejected.
+func (a AssertableString) IsValidMACAddress() AssertableString {
+	if _, err := utils.ParseMACAddress(a.actual.DecoratedValue()); err != nil {
+		a.error(shouldBeValidMACAddress(a.actual, err))
+	}
+	return a
+}
+
+// IsValidBase32 asserts if the assertable string is a valid base32 encoded value
+// It uses the standard RFC 4648 alphabet unless UsingBase32HexEncoding is set.
+// It errors the test if the string can't be decoded.
+func (a AssertableString) IsValidBase32() AssertableString {
+	if _, err := a.base32Encoding.DecodeString(a.actual.DecoratedValue()); err != nil {
+		a.error(shouldBeValidBase32(a.actual, err))
+	}
+	return a
+}
+
+// Base32DecodesTo asserts if the assertable string is a base32 encoded value of the expected string
+// It uses the standard RFC 4648 alphabet unless UsingBase32HexEncoding is set.
+// It errors the test if the string can't be decoded or the decoded value is not equal to the expected one.
+func (a AssertableString) Base32DecodesTo(expected string) AssertableString {
+	decoded, err := a.base32Encoding.DecodeString(a.actual.DecoratedValue())
+	if err != nil {
+		a.error(shouldBeValidBase32(a.actual, err))
+		return a
+	}
+	if string(decoded) != expected {
+		a.error(shouldDecodeTo(a.actual, expected, string(decoded)))
+	}
+	return a
+}
+
+// TrimmedEquals asserts if the assertable string is equal to the expected string after removing its leading and
+// trailing white spaces. The options of the assertable string, such as IgnoringCase, apply to the expected string too.
+// It errors the tests if the compared values (trimmed actual VS expected) are not equal.
+func (a AssertableString) TrimmedEquals(expected string) AssertableString {
+	if trimmed, decorated := strings.TrimSpace(a.actual.DecoratedValue()), a.actual.Decorate(expected); trimmed != decorated {
+		a.error(shouldBeEqualTrimmed(a.actual, trimmed, decorated))
+	}
+	return a
+}
+
+// IsEqualToIgnoringWhitespace asserts if the assertable string is equal to the expected string after collapsing every
+// run of white spaces, such as spaces, tabs and new lines, of both to a single space and trimming their leading and
+// trailing white spaces. Words still need to be separated, "a b" is not equal to "ab". It can be combined with other
+// options such as IgnoringCase.
+// It errors the tests if the compared values are not equal, reporting the original values.
+func (a AssertableString) IsEqualToIgnoringWhitespace(expected string) AssertableString {
+	if !a.actual.IsEqualToIgnoringWhitespace(expected) {
+		a.error(shouldBeEqualIgnoringWhitespace(a.actual, expected))
+	}
+	return a
+}
+
+// IsEqualToIgnoringCase asserts if the assertable string is equal to the expected string under Unicode case folding,
+// leaving the value seen by the following assertions unchanged, unlike the IgnoringCase option.
+// It errors the tests if the compared values are not equal ignoring their case.
+func (a AssertableString) IsEqualToIgnoringCase(expected string) AssertableString {
+	if !a.actual.EqualsIgnoringCase(expected) {
+		a.error(shouldBeEqualIgnoringCase(a.actual, expected))
+	}
+	return a
+}
+
+// DedentedEquals asserts if the assertable string is equal to the expected string after removing the common leading
+// indentation of the non-blank lines from both, so indented multi-line literals can be compared. The options of the
+// assertable string, such as IgnoringCase, apply to the expected string too.
+// It errors the tests if the compared values (dedented actual VS dedented expected) are not equal.
+func (a AssertableString) DedentedEquals(expected string) AssertableString {
+	dedentedActual, dedentedExpected := values.Dedent(a.actual.DecoratedValue()), values.Dedent(a.actual.Decorate(expected))
+	if dedentedActual != dedentedExpected {
+		a.error(shouldBeEqualDedented(dedentedActual, dedentedExpected))
+	}
+	return a
+}
+
+// IsValidDuration asserts if the assertable string is a valid duration such as "30s" or "1h15m"
+// It errors the test if the string can't be parsed by time.ParseDuration.
+func (a AssertableString) IsValidDuration() AssertableString {
+	if _, err := time.ParseDuration(a.actual.DecoratedValue()); err != nil {
+		a.error(shouldBeValidDuration(a.actual, err))
 	}
 	return a
 }
+
+// ParsesAsDuration parses the assertable string as a duration and returns an AssertableDuration over the parsed value
+// It errors the test if the string can't be parsed by time.ParseDuration and the returned structure holds a zero
+// duration.
+func (a AssertableString) ParsesAsDuration() AssertableDuration {
+	duration, err := time.ParseDuration(a.actual.DecoratedValue())
+	if err != nil {
+		a.error(shouldBeValidDuration(a.actual, err))
+	}
+	return thatDuration(a.assertion, duration)
+}
+
+// IsNumeric asserts if the assertable string is a decimal number, with an optional sign, decimal point and exponent,
+// such as "-42", "3.14" or "1e6". Unlike ContainsOnlyDigits it accepts any string that strconv.ParseFloat can parse,
+// except the infinity and NaN values.
+// It errors the test if the string is not a number.
+func (a AssertableString) IsNumeric() AssertableString {
+	if _, err := a.parseFloat(); err != nil {
+		a.error(shouldBeNumeric(a.actual, err))
+	}
+	return a
+}
+
+// AsInt parses the assertable string as a base 10 integer and returns an AssertableInt over the parsed value
+// It errors the test if the string can't be parsed by strconv.Atoi and the returned structure holds zero.
+func (a AssertableString) AsInt() AssertableInt {
+	value, err := strconv.Atoi(a.actual.DecoratedValue())
+	if err != nil {
+		a.error(shouldBeNumeric(a.actual, err))
+		return thatInt(a.assertion, 0)
+	}
+	return thatInt(a.assertion, value)
+}
+
+// AsFloat64 parses the assertable string as a number and returns an AssertableFloat64 over the parsed value, accepting
+// the same numbers as IsNumeric.
+// It errors the test if the string is not a number and the returned structure holds zero.
+func (a AssertableString) AsFloat64() AssertableFloat64 {
+	value, err := a.parseFloat()
+	if err != nil {
+		a.error(shouldBeNumeric(a.actual, err))
+		return thatFloat64(a.assertion, 0)
+	}
+	return thatFloat64(a.assertion, value)
+}
+
+func (a AssertableString) parseFloat() (float64, error) {
+	value, err := strconv.ParseFloat(a.actual.DecoratedValue(), 64)
+	if err == nil && (math.IsInf(value, 0) || math.IsNaN(value)) {
+		return 0, strconv.ErrSyntax
+	}
+	return value, err
+}
+
+// MatchesRegexp asserts if the assertable string matches the given regular expression. The pattern is not anchored
+// unless it uses ^ and $ explicitly.
+// It errors the test if the pattern can't be compiled or the string doesn't match it.
+func (a AssertableString) MatchesRegexp(pattern string) AssertableString {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		a.error(shouldBeValidRegexp(pattern, err))
+		return a
+	}
+	if !re.MatchString(a.actual.DecoratedValue()) {
+		a.error(shouldMatch(a.actual, pattern))
+	}
+	return a
+}
+
+// DoesNotMatchRegexp asserts if the assertable string doesn't match the given regular expression
+// It errors the test if the pattern can't be compiled or the string matches it.
+func (a AssertableString) DoesNotMatchRegexp(pattern string) AssertableString {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		a.error(shouldBeValidRegexp(pattern, err))
+		return a
+	}
+	if match := re.FindStringIndex(a.actual.DecoratedValue()); match != nil {
+		a.error(shouldNotMatch(a.actual, pattern, a.actual.DecoratedValue()[match[0]:match[1]]))
+	}
+	return a
+}
+
+// EachLineMatches asserts if every non-empty line of the assertable string matches the given regular expression
+// It errors the test if the pattern can't be compiled or any non-empty line doesn't match it.
+func (a AssertableString) EachLineMatches(pattern string) AssertableString {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		a.error(shouldBeValidRegexp(pattern, err))
+		return a
+	}
+	for i, line := range strings.Split(a.actual.DecoratedValue(), "\n") {
+		if line != "" && !re.MatchString(line) {
+			a.error(shouldHaveEachLineMatching(a.actual, pattern, i+1, line))
+			return a
+		}
+	}
+	return a
+}
+
+// SomeLineMatches asserts if at least one line of the assertable string matches the given regular expression
+// It errors the test if the pattern can't be compiled or no line matches it.
+func (a AssertableString) SomeLineMatches(pattern string) AssertableString {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		a.error(shouldBeValidRegexp(pattern, err))
+		return a
+	}
+	for _, line := range strings.Split(a.actual.DecoratedValue(), "\n") {
+		if re.MatchString(line) {
+			return a
+		}
+	}
+	a.error(shouldHaveSomeLineMatching(a.actual, pattern))
+	return a
+}
+
+// MatchGroupEquals asserts if the given group captured by the regular expression in the assertable string is equal to
+// the expected value. Group 0 is the whole match and groups 1 to n are the parenthesized sub-expressions.
+// It errors the test if
+// * the pattern can't be compiled
+// * the group index is out of the range of the pattern groups
+// * the pattern doesn't match the string
+// * the captured group is not equal to the expected value.
+func (a AssertableString) MatchGroupEquals(pattern string, groupIndex int, expected string) AssertableString {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		a.error(shouldBeValidRegexp(pattern, err))
+		return a
+	}
+	if groupIndex < 0 || groupIndex > re.NumSubexp() {
+		a.error(shouldHaveMatchGroup(pattern, groupIndex, re.NumSubexp()))
+		return a
+	}
+	matches := re.FindStringSubmatch(a.actual.DecoratedValue())
+	if matches == nil {
+		a.error(shouldMatch(a.actual, pattern))
+		return a
+	}
+	if matches[groupIndex] != expected {
+		a.error(shouldHaveMatchGroupEqualTo(a.actual, pattern, groupIndex, expected, matches[groupIndex]))
+	}
+	return a
+}
+
+// MatchesNoneOf asserts if the assertable string matches none of the given regular expressions
+// It errors the test if any of the patterns can't be compiled or matches the string.
+func (a AssertableString) MatchesNoneOf(patterns ...string) AssertableString {
+	expressions := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			a.error(shouldBeValidRegexp(pattern, err))
+			return a
+		}
+		expressions = append(expressions, re)
+	}
+	for _, re := range expressions {
+		if match := re.FindStringIndex(a.actual.DecoratedValue()); match != nil {
+			a.error(shouldNotMatch(a.actual, re.String(), a.actual.DecoratedValue()[match[0]:match[1]]))
+		}
+	}
+	return a
+}
+
+// LeadingSpaces returns an AssertableInt over the number of the leading whitespace characters of the first line of the
+// assertable string. Tabs count as many as the width set by WithTabWidth.
+func (a AssertableString) LeadingSpaces() AssertableInt {
+	return a.LeadingSpacesOfLine(1)
+}
+
+// LeadingSpacesOfLine returns an AssertableInt over the number of the leading whitespace characters of the given line
+// of the assertable string, counting lines from 1. Tabs count as many as the width set by WithTabWidth.
+// It errors the test if the string has no such line and the returned structure holds zero.
+func (a AssertableString) LeadingSpacesOfLine(line int) AssertableInt {
+	lines := strings.Split(a.actual.DecoratedValue(), "\n")
+	if line < 1 || line > len(lines) {
+		a.error(shouldHaveLine(a.actual, line, len(lines)))
+		return thatInt(a.assertion, 0)
+	}
+
+	count := 0
+	for _, c := range lines[line-1] {
+		if c == '\t' {
+			count += a.tabWidth
+			continue
+		}
+		if !unicode.IsSpace(c) {
+			break
+		}
+		count++
+	}
+	return thatInt(a.assertion, count)
+}
+
+// ByteAt returns an AssertableInt over the byte at the given byte offset of the assertable string, counting from 0.
+// A multi-byte character spans many offsets, so the byte at an offset may be only part of a character. Use RuneAt to
+// index the string by character.
+// It errors the test if the offset is out of the string bounds and the returned structure holds zero.
+func (a AssertableString) ByteAt(i int) AssertableInt {
+	actual := a.actual.DecoratedValue()
+	if i < 0 || i >= len(actual) {
+		a.error(shouldHaveIndex(a.actual, "byte", i, len(actual)))
+		return thatInt(a.assertion, 0)
+	}
+	return thatInt(a.assertion, int(actual[i]))
+}
+
+// RuneAt returns an AssertableValue over the rune at the given rune position of the assertable string, counting from 0.
+// Unlike ByteAt the position counts characters, not bytes, so in "café" the rune at position 3 is 'é'.
+// It errors the test if the position is out of the string bounds and the returned structure holds the zero rune.
+func (a AssertableString) RuneAt(i int) AssertableValue[rune] {
+	actual := []rune(a.actual.DecoratedValue())
+	if i < 0 || i >= len(actual) {
+		a.error(shouldHaveIndex(a.actual, "rune", i, len(actual)))
+		return thatValue[rune](a.assertion, 0)
+	}
+	return thatValue(a.assertion, actual[i])
+}
+
+// HashEquals asserts if the hex encoded hash of the assertable string is equal to the expected hash, which is useful
+// for snapshot tests of large values. The hash is computed with SHA-256 unless HashingWith sets a different function.
+// It errors the test if the hashes are not equal or the hash function is not available.
+func (a AssertableString) HashEquals(expected string) AssertableString {
+	if !a.hash.Available() {
+		a.error(shouldHaveAvailableHash(a.hash))
+		return a
+	}
+	h := a.hash.New()
+	h.Write([]byte(a.actual.DecoratedValue()))
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected) {
+		a.error(shouldHaveHash(a.actual, expected, actual))
+	}
+	return a
+}
+
+// EditDistanceTo returns an AssertableInt over the Levenshtein distance between the assertable string and the given
+// string, counted in runes.
+func (a AssertableString) EditDistanceTo(other string) AssertableInt {
+	return thatInt(a.assertion, a.actual.EditDistance(other))
+}
+
+// ColumnAt returns an AssertableString over the fixed-width field of the assertable string between the start
+// (inclusive) and end (exclusive) byte offsets. The field is extracted from the value as given, before applying any of
+// the string options, and its leading and trailing white spaces are removed if trim is true.
+// It errors the test if the range is out of the string bounds and the returned structure holds an empty string.
+func (a AssertableString) ColumnAt(start, end int, trim bool) AssertableString {
+	value := a.actual.Value().(string)
+	if start < 0 || start > end || end > len(value) {
+		a.error(shouldHaveColumn(a.actual, start, end))
+		return thatString(a.assertion, "")
+	}
+	column := value[start:end]
+	if trim {
+		column = strings.TrimSpace(column)
+	}
+	return thatString(a.assertion, column)
+}
+
+// IsValidCron asserts if the assertable string is a valid cron expression of the five standard fields, or six fields
+// starting with the seconds if WithCronSeconds is set. Fields may contain wildcards, values, ranges, steps and lists,
+// and the month and day of week fields may use the three letter names as well.
+// It errors the test with the field that failed to parse if the expression is not valid.
+func (a AssertableString) IsValidCron() AssertableString {
+	if err := utils.ValidateCronExpression(a.actual.DecoratedValue(), a.cronSeconds); err != nil {
+		a.error(shouldBeValidCron(a.actual, err))
+	}
+	return a
+}
+
+// IsValidHexColor asserts if the assertable string is a #RGB, #RRGGBB or #RRGGBBAA hex color, case insensitively
+// The leading # is required unless WithOptionalHexColorHash is set.
+// It errors the test if the string is not a valid hex color.
+func (a AssertableString) IsValidHexColor() AssertableString {
+	if _, err := utils.ParseHexColor(a.actual.DecoratedValue(), a.hexColorHashOptional); err != nil {
+		a.error(shouldBeValidHexColor(a.actual, err))
+	}
+	return a
+}
+
+// HexColorComponents returns an AssertableSlice over the red, green, blue and, if present, alpha components of the
+// assertable hex color as ints. Short #RGB colors are expanded so #fa0 has the components of #ffaa00.
+// It errors the test if the string is not a valid hex color and the returned structure holds no components.
+func (a AssertableString) HexColorComponents() AssertableSlice {
+	components, err := utils.ParseHexColor(a.actual.DecoratedValue(), a.hexColorHashOptional)
+	if err != nil {
+		a.error(shouldBeValidHexColor(a.actual, err))
+		return thatSlice(a.assertion, []int{})
+	}
+	return thatSlice(a.assertion, components)
+}
+
+// IsAnagramOf asserts if the assertable string consists of exactly the same characters as the given string, regardless
+// of their order. The string options apply to both strings, so IgnoringCase, IgnoringWhiteSpaces and
+// IgnoringPunctuation can be combined to compare phrases such as "Dormitory" and "Dirty room!".
+// It errors the test if the strings are not anagrams.
+func (a AssertableString) IsAnagramOf(other string) AssertableString {
+	if !a.actual.IsAnagramOf(other) {
+		actualRunes, otherRunes := a.actual.SortedRunes(other)
+		a.error(shouldBeAnagramOf(a.actual, other, actualRunes, otherRunes))
+	}
+	return a
+}
+
+// IsValidXML asserts if the assertable string is a valid XML document
+// It errors the test if the string can't be parsed as XML, reporting the first syntax error and its position.
+func (a AssertableString) IsValidXML() AssertableString {
+	if _, err := utils.CanonicalXML(a.actual.DecoratedValue()); err != nil {
+		a.error(shouldBeValidXML(a.actual, err))
+	}
+	return a
+}
+
+// IsXMLEqualTo asserts if the assertable string is an XML document equal to the expected one. The documents are
+// compared in their canonical form, so whitespace between elements, attribute order, comments and processing
+// instructions are ignored.
+// It errors the test if
+// * any of the two strings is not a valid XML document
+// * the canonical forms of the two documents are not equal.
+func (a AssertableString) IsXMLEqualTo(expected string) AssertableString {
+	actual, err := utils.CanonicalXML(a.actual.DecoratedValue())
+	if err != nil {
+		a.error(shouldBeValidXML(a.actual, err))
+		return a
+	}
+	canonicalExpected, err := utils.CanonicalXML(expected)
+	if err != nil {
+		a.error(shouldBeValidXML(values.NewStringValue(expected), err))
+		return a
+	}
+	if actual != canonicalExpected {
+		a.error(shouldBeXMLEqual(canonicalExpected, actual))
+	}
+	return a
+}
+
+// IsValidJSON asserts if the assertable string is a valid JSON document
+// It errors the test if the string can't be parsed by encoding/json, reporting the syntax error.
+func (a AssertableString) IsValidJSON() AssertableString {
+	if _, _, err := utils.ParseJSON(a.actual.DecoratedValue()); err != nil {
+		a.error(shouldBeValidJSON(a.actual, err))
+	}
+	return a
+}
+
+// HasJSONEqualTo asserts if the assertable string is a JSON document semantically equal to the expected one. Both
+// documents are unmarshaled into interface{} values and compared using reflect.DeepEqual, so key order and whitespace
+// are ignored.
+// It errors the test if
+// * any of the two strings is not a valid JSON document
+// * the two documents are not equal, reporting their normalized forms.
+func (a AssertableString) HasJSONEqualTo(expected string) AssertableString {
+	actual, normalizedActual, err := utils.ParseJSON(a.actual.DecoratedValue())
+	if err != nil {
+		a.error(shouldBeValidJSON(a.actual, err))
+		return a
+	}
+	expectedValue, normalizedExpected, err := utils.ParseJSON(expected)
+	if err != nil {
+		a.error(shouldBeValidJSON(values.NewStringValue(expected), err))
+		return a
+	}
+	if !reflect.DeepEqual(actual, expectedValue) {
+		a.error(shouldBeJSONEqual(normalizedExpected, normalizedActual))
+	}
+	return a
+}
+
+// WordCountMatching returns an AssertableInt over the number of the words of the assertable string that satisfy the
+// given predicate. Words are the substrings separated by whitespace, as split by strings.Fields.
+func (a AssertableString) WordCountMatching(predicate func(word string) bool) AssertableInt {
+	count := 0
+	for _, word := range strings.Fields(a.actual.DecoratedValue()) {
+		if predicate(word) {
+			count++
+		}
+	}
+	return thatInt(a.assertion, count)
+}
+
+// HasMinEntropyBits asserts if the estimated entropy of the assertable string is at least the given number of bits.
+// The entropy is estimated as the Shannon entropy of the character frequencies times the number of characters. The
+// estimate is approximate: it ignores the order of the characters, so strings with repeating patterns such as
+// "abcabc" are estimated as random as any string with the same character frequencies.
+// It errors the test if the estimated entropy is less than the given number of bits.
+func (a AssertableString) HasMinEntropyBits(bits float64) AssertableString {
+	if entropy := a.actual.EntropyBits(); entropy < bits {
+		a.error(shouldHaveMinEntropyBits(a.actual, bits, entropy))
+	}
+	return a
+}
+
+// IsConstantTimeEqualTo asserts if the assertable string is equal to the expected string comparing their bytes with
+// crypto/subtle.ConstantTimeCompare, as code comparing secrets such as tokens or MACs should do. The compared values
+// are not reported on failure so secrets don't leak to the test output.
+// It errors the test if
+// * the lengths of the two strings differ
+// * the strings are not equal.
+func (a AssertableString) IsConstantTimeEqualTo(expected string) AssertableString {
+	actual := a.actual.DecoratedValue()
+	if len(actual) != len(expected) {
+		a.error(shouldHaveSameLengthSecret(len(actual), len(expected)))
+		return a
+	}
+	if subtle.ConstantTimeCompare([]byte(actual), []byte(expected)) != 1 {
+		a.error(shouldBeConstantTimeEqual())
+	}
+	return a
+}
+
+// CommonPrefixWith returns an AssertableString over the longest common prefix of the assertable string and the given
+// string. The options of the assertable string, such as IgnoringCase, apply to the given string too. The strings are
+// compared rune by rune, so the prefix never ends in the middle of a multi-byte character.
+func (a AssertableString) CommonPrefixWith(other string) AssertableString {
+	actual, otherRunes := []rune(a.actual.DecoratedValue()), []rune(a.actual.Decorate(other))
+	length := 0
+	for length < len(actual) && length < len(otherRunes) && actual[length] == otherRunes[length] {
+		length++
+	}
+	return thatString(a.assertion, string(actual[:length]))
+}
+
+// EqualsWithMask asserts if the assertable string is equal to the expected string, where every position of the
+// expected string holding the mask character matches any character, for example "ab**ef" with mask '*' matches
+// "abXYef". The options of the assertable string, such as IgnoringCase, apply to the expected string too, but not to
+// the mask character. The strings are compared rune by rune.
+// It errors the test if the strings don't have the same length or on the first position that doesn't match.
+func (a AssertableString) EqualsWithMask(expected string, maskChar rune) AssertableString {
+	actual, expectedRunes := []rune(a.actual.DecoratedValue()), []rune(a.actual.Decorate(expected))
+	if len(actual) != len(expectedRunes) {
+		a.error(shouldHaveSameLengthAsMask(a.actual, expected, len(expectedRunes), len(actual)))
+		return a
+	}
+	for i := range expectedRunes {
+		if expectedRunes[i] != maskChar && expectedRunes[i] != actual[i] {
+			a.error(shouldMatchMask(a.actual, expected, i, expectedRunes[i], actual[i]))
+			return a
+		}
+	}
+	return a
+}
+
+// ContainsValidMarkdownLinks asserts if every [text](url) markdown link of the assertable string has a non-empty text
+// and a non-empty url that can be parsed by url.Parse. A string without links passes.
+// It errors the test reporting the first malformed link and whether its text or its url is invalid.
+func (a AssertableString) ContainsValidMarkdownLinks() AssertableString {
+	for _, link := range markdownLinkPattern.FindAllStringSubmatch(a.actual.DecoratedValue(), -1) {
+		if strings.TrimSpace(link[1]) == "" {
+			a.error(shouldBeValidMarkdownLink(link[0], "its text is empty"))
+			return a
+		}
+		target := strings.TrimSpace(link[2])
+		if target == "" {
+			a.error(shouldBeValidMarkdownLink(link[0], "its url is empty"))
+			return a
+		}
+		if _, err := url.Parse(target); err != nil {
+			a.error(shouldBeValidMarkdownLink(link[0], "its url is invalid: "+err.Error()))
+			return a
+		}
+	}
+	return a
+}
+
+// IsRedacted asserts if the assertable string contains no match of the PIIPatterns or of the given custom regular
+// expressions, as a value should after the sensitive data of it are redacted.
+// It errors the test if
+// * any of the given patterns can't be compiled
+// * any of the patterns matches the string, reporting the pattern and the match.
+func (a AssertableString) IsRedacted(patterns ...string) AssertableString {
+	expressions := make([]*regexp.Regexp, 0, len(PIIPatterns)+len(patterns))
+	for _, pattern := range append(append([]string{}, PIIPatterns...), patterns...) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			a.error(shouldBeValidRegexp(pattern, err))
+			return a
+		}
+		expressions = append(expressions, re)
+	}
+	for _, re := range expressions {
+		for _, match := range re.FindAllString(a.actual.DecoratedValue(), -1) {
+			if re.String() == creditCardPattern && !utils.PassesLuhn(match) {
+				continue
+			}
+			a.error(shouldBeRedacted(a.actual, re.String(), match))
+			return a
+		}
+	}
+	return a
+}
+
+// And returns the assertable unchanged, so chained assertions can read more fluently, for example
+// IsNotEmpty().And().StartsWith("a").
+func (a AssertableString) And() AssertableString {
+	return a
+}
+
+// Also is an alias of And.
+func (a AssertableString) Also() AssertableString {
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableString) WithMessage(message string) AssertableString {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableString) AsRequirement() AssertableString {
+	a.requirement = true
+	return a
+}
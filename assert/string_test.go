@@ -1,10 +1,102 @@
 package assert
 
 import (
+	"crypto"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
+	"unicode"
+
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
 )
 
+func TestAssertableString_IsOneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		candidates []string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:       "should assert value in the middle of the candidates",
+			actual:     "pending",
+			candidates: []string{"created", "pending", "done"},
+		},
+		{
+			name:       "should fail for value not in the candidates",
+			actual:     "failed",
+			candidates: []string{"created", "pending", "done"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail without candidates",
+			actual:     "pending",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert ignoring case",
+			actual:     "Pending",
+			candidates: []string{"created", "PENDING"},
+			opts:       []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for different case",
+			actual:     "Pending",
+			candidates: []string{"created", "PENDING"},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).IsOneOf(tt.candidates...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsNoneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		candidates []string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:       "should assert value not in the candidates",
+			actual:     "failed",
+			candidates: []string{"created", "pending", "done"},
+		},
+		{
+			name:       "should fail for value in the middle of the candidates",
+			actual:     "pending",
+			candidates: []string{"created", "pending", "done"},
+			shouldFail: true,
+		},
+		{
+			name:   "should assert without candidates",
+			actual: "pending",
+		},
+		{
+			name:       "should fail ignoring case",
+			actual:     "Pending",
+			candidates: []string{"created", "PENDING"},
+			opts:       []StringOpt{IgnoringCase()},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).IsNoneOf(tt.candidates...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
 func TestAssertableString_IsEmpty(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -57,6 +149,78 @@ func TestAssertableString_IsNotEmpty(t *testing.T) {
 	}
 }
 
+func TestAssertableString_IsBlank(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert empty string",
+			actual:     "",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert spaces only string",
+			actual:     "   ",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert tab and new line only string",
+			actual:     "\t\n",
+			shouldFail: false,
+		},
+		{
+			name:       "should fail for string with non-white space characters",
+			actual:     " x ",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsBlank()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsNotBlank(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:       "should fail for empty string",
+			actual:     "",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for spaces only string",
+			actual:     "   ",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for tab and new line only string",
+			actual:     "\t\n",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert string with non-white space characters",
+			actual:     " x ",
+			shouldFail: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsNotBlank()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
 func TestAssertableString_IsEqualTo(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -176,6 +340,101 @@ func TestAssertableString_Contains(t *testing.T) {
 	}
 }
 
+func TestAssertableString_ContainsAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		substrings []string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:       "should assert all substrings",
+			actual:     "I'm a happy man",
+			substrings: []string{"happy", "man", "I'm"},
+		},
+		{
+			name:       "should assert overlapping substrings",
+			actual:     "banana",
+			substrings: []string{"ana", "nan", "banana"},
+		},
+		{
+			name:   "should assert without substrings",
+			actual: "I'm a happy man",
+		},
+		{
+			name:       "should fail for missing substrings",
+			actual:     "I'm a happy man",
+			substrings: []string{"happy", "woman", "sad"},
+			shouldFail: true,
+		},
+		{
+			name:       "should assert ignoring case",
+			actual:     "I'm a Happy MAN",
+			substrings: []string{"happy", "man"},
+			opts:       []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for different case",
+			actual:     "I'm a Happy MAN",
+			substrings: []string{"happy", "man"},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).ContainsAll(tt.substrings...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_ContainsAny(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		substrings []string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:       "should assert one of the substrings",
+			actual:     "I'm a happy man",
+			substrings: []string{"sad", "happy", "woman"},
+		},
+		{
+			name:       "should assert overlapping substrings",
+			actual:     "banana",
+			substrings: []string{"nab", "nan"},
+		},
+		{
+			name:       "should fail without substrings",
+			actual:     "I'm a happy man",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for none of the substrings",
+			actual:     "I'm a happy man",
+			substrings: []string{"sad", "woman"},
+			shouldFail: true,
+		},
+		{
+			name:       "should assert ignoring case",
+			actual:     "I'm a Happy MAN",
+			substrings: []string{"sad", "man"},
+			opts:       []StringOpt{IgnoringCase()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).ContainsAny(tt.substrings...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
 func TestAssertableString_ContainsIgnoringCase(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -551,151 +810,425 @@ func TestAssertableString_HasSameSizeAs(t *testing.T) {
 	}
 }
 
-func TestAssertableString_ContainsOnlyDigits(t *testing.T) {
+func TestWithTransform_TransformsExpectedOnce(t *testing.T) {
+	exclaim := WithTransform(func(value string) string { return value + "!" })
+
+	test := &testing.T{}
+	ThatString(test, "ab", exclaim).
+		IsEqualTo("ab").
+		ContainsOnly("ab").
+		Contains("ab").
+		DoesNotContain("ab!").
+		ContainsOnlyOnce("ab")
+	ThatBool(t, test.Failed()).IsFalse()
+
+	test = &testing.T{}
+	ThatString(test, "ab", exclaim).DoesNotContain("ab")
+	ThatBool(t, test.Failed()).IsTrue()
+
+	actual := values.NewStringValue("b").AddDecorator(func(value string) string { return value + "!" })
+	ThatBool(t, actual.IsLessThan("b")).IsFalse()
+	ThatBool(t, actual.IsLessOrEqualTo("b")).IsTrue()
+}
+
+func TestWithTransform(t *testing.T) {
+	stripANSI := func(value string) string {
+		return regexp.MustCompile("\x1b\\[[0-9;]*m").ReplaceAllString(value, "")
+	}
 	tests := []struct {
 		name       string
 		actual     string
+		expected   string
+		opts       []StringOpt
 		shouldFail bool
 	}{
 		{
-			name:       "should succeed if it only contains digits",
-			actual:     "1234567890",
-			shouldFail: false,
-		},
-		{
-			name:       "should succeed if it only contains one digit",
-			actual:     "4",
-			shouldFail: false,
-		},
-		{
-			name:       "should succeed if it contains huge number",
-			actual:     "18446744073709551616",
-			shouldFail: false,
-		},
-		{
-			name:       "should succeed if it contains huge number and a character",
-			actual:     "a18446744073709551616",
-			shouldFail: true,
+			name:     "should assert transformed value",
+			actual:   "\x1b[31merror\x1b[0m: failed",
+			expected: "error: failed",
+			opts:     []StringOpt{WithTransform(stripANSI)},
 		},
 		{
-			name:       "should fail if contains a letter",
-			actual:     "01e",
-			shouldFail: true,
+			name:     "should transform expected value",
+			actual:   "\x1b[31merror\x1b[0m",
+			expected: "\x1b[1merror",
+			opts:     []StringOpt{WithTransform(stripANSI)},
 		},
 		{
-			name:       "should fail if contains only letters",
-			actual:     "test",
-			shouldFail: true,
+			name:     "should compose transforms in order",
+			actual:   "  Error ",
+			expected: "error",
+			opts: []StringOpt{
+				WithTransform(strings.TrimSpace),
+				IgnoringCase(),
+				WithTransform(func(value string) string { return value + "!" }),
+			},
 		},
 		{
-			name:       "should fail if contains empty string",
-			actual:     " ",
+			name:       "should fail without transform",
+			actual:     "\x1b[31merror\x1b[0m: failed",
+			expected:   "error: failed",
 			shouldFail: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			test := &testing.T{}
-			ft := NewFluentT(test)
-			ft.AssertThatString(tt.actual).ContainsOnlyDigits()
+			ThatString(test, tt.actual, tt.opts...).IsEqualTo(tt.expected)
 			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
 		})
 	}
 }
 
-func TestAssertableString_DoesNotStartWith(t *testing.T) {
+func TestAssertableString_CountingRunes(t *testing.T) {
 	tests := []struct {
 		name       string
-		actual     string
-		substr     string
+		assert     func(test *testing.T, opts ...StringOpt)
+		opts       []StringOpt
 		shouldFail bool
 	}{
 		{
-			name:       "should assert string that does not start with",
-			actual:     "My name is Bond",
-			substr:     "Je m'appelle Bond",
-			shouldFail: false,
+			name: "should count bytes by default",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "café", opts...).HasLength(5)
+			},
 		},
 		{
-			name:       "should assert string that starts with",
-			actual:     "My name is Bond",
-			substr:     "My name",
+			name: "should count runes",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "café", opts...).HasLength(4)
+			},
+			opts: []StringOpt{CountingRunes()},
+		},
+		{
+			name: "should fail for byte count when counting runes",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "café", opts...).HasLength(5)
+			},
+			opts:       []StringOpt{CountingRunes()},
+			shouldFail: true,
+		},
+		{
+			name: "should fail for same rune count by default",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "café", opts...).HasSameSizeAs("cafe")
+			},
 			shouldFail: true,
 		},
+		{
+			name: "should assert same rune count",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "café", opts...).HasSameSizeAs("cafe")
+			},
+			opts: []StringOpt{CountingRunes()},
+		},
+		{
+			name: "should compare runes for longer and shorter",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "café", opts...).IsShorterThan(5).IsLongerThan(3)
+			},
+			opts: []StringOpt{CountingRunes()},
+		},
+		{
+			name: "should count runes of emoji",
+			assert: func(test *testing.T, opts ...StringOpt) {
+				ThatString(test, "ok 👍", opts...).HasLength(4)
+			},
+			opts: []StringOpt{CountingRunes()},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			test := &testing.T{}
-			ThatString(test, tt.actual).DoesNotStartWith(tt.substr)
+			tt.assert(test, tt.opts...)
 			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
 		})
 	}
 }
 
-func TestAssertableString_DoesNotEndWith(t *testing.T) {
+func TestAssertableString_HasLength(t *testing.T) {
 	tests := []struct {
 		name       string
 		actual     string
-		substr     string
+		length     int
 		shouldFail bool
 	}{
 		{
-			name:       "should assert string that ends with",
-			actual:     "My name is Bond",
-			substr:     "Bond",
+			name:   "should assert string length",
+			actual: "abcd",
+			length: 4,
+		},
+		{
+			name:   "should assert empty string length",
+			actual: "",
+			length: 0,
+		},
+		{
+			name:   "should count bytes of multi-byte characters",
+			actual: "café",
+			length: 5,
+		},
+		{
+			name:       "should fail for rune count of multi-byte characters",
+			actual:     "café",
+			length:     4,
 			shouldFail: true,
 		},
 		{
-			name:       "should assert string that does not end with",
-			actual:     "My name is Bond",
-			substr:     "My name",
-			shouldFail: false,
+			name:       "should fail for different length",
+			actual:     "abcd",
+			length:     3,
+			shouldFail: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			test := &testing.T{}
-			ThatString(test, tt.actual).DoesNotEndWith(tt.substr)
+			ThatString(test, tt.actual).HasLength(tt.length)
 			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
 		})
 	}
 }
 
-func TestAssertableString_IsLowerCase(t *testing.T) {
+func TestAssertableString_IsLongerThan(t *testing.T) {
 	tests := []struct {
 		name       string
 		actual     string
+		length     int
 		shouldFail bool
 	}{
 		{
-			name:       "should assert string that is not lower case",
-			actual:     "My name is Bond",
+			name:   "should assert longer string",
+			actual: "abcd",
+			length: 3,
+		},
+		{
+			name:   "should count bytes of multi-byte characters",
+			actual: "café",
+			length: 4,
+		},
+		{
+			name:       "should fail for equal length",
+			actual:     "abcd",
+			length:     4,
 			shouldFail: true,
 		},
 		{
-			name:       "should assert string that is lower case",
-			actual:     "my name is bond",
-			shouldFail: false,
+			name:       "should fail for shorter string",
+			actual:     "abc",
+			length:     4,
+			shouldFail: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			test := &testing.T{}
-			ThatString(test, tt.actual).IsLowerCase()
+			ThatString(test, tt.actual).IsLongerThan(tt.length)
 			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
 		})
 	}
 }
 
-func TestAssertableString_IsUpperCase(t *testing.T) {
+func TestAssertableString_IsShorterThan(t *testing.T) {
 	tests := []struct {
 		name       string
 		actual     string
+		length     int
 		shouldFail bool
 	}{
 		{
-			name:       "should assert string that is not upper case",
-			actual:     "My name is Bond",
+			name:   "should assert shorter string",
+			actual: "abc",
+			length: 4,
+		},
+		{
+			name:       "should fail for equal length",
+			actual:     "abcd",
+			length:     4,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for longer string",
+			actual:     "abcd",
+			length:     3,
+			shouldFail: true,
+		},
+		{
+			name:       "should count bytes of multi-byte characters",
+			actual:     "café",
+			length:     5,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsShorterThan(tt.length)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_ContainsOnlyDigits(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:       "should succeed if it only contains digits",
+			actual:     "1234567890",
+			shouldFail: false,
+		},
+		{
+			name:       "should succeed if it only contains one digit",
+			actual:     "4",
+			shouldFail: false,
+		},
+		{
+			name:       "should succeed if it contains huge number",
+			actual:     "18446744073709551616",
+			shouldFail: false,
+		},
+		{
+			name:       "should succeed if it contains huge number and a character",
+			actual:     "a18446744073709551616",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if contains a letter",
+			actual:     "01e",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if contains only letters",
+			actual:     "test",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if contains empty string",
+			actual:     " ",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ft := NewFluentT(test)
+			ft.AssertThatString(tt.actual).ContainsOnlyDigits()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_DoesNotStartWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		substr     string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert string that does not start with",
+			actual:     "My name is Bond",
+			substr:     "Je m'appelle Bond",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert string that starts with",
+			actual:     "My name is Bond",
+			substr:     "My name",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).DoesNotStartWith(tt.substr)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_DoesNotEndWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		substr     string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert string that ends with",
+			actual:     "My name is Bond",
+			substr:     "Bond",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert string that does not end with",
+			actual:     "My name is Bond",
+			substr:     "My name",
+			shouldFail: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).DoesNotEndWith(tt.substr)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsLowerCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert string that is not lower case",
+			actual:     "My name is Bond",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert string that is lower case",
+			actual:     "my name is bond",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert string that is all caps",
+			actual:     "MY NAME IS BOND",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert lower case string with digits",
+			actual:     "agent-007",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert digits only string",
+			actual:     "007",
+			shouldFail: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsLowerCase()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsUpperCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert string that is not upper case",
+			actual:     "My name is Bond",
 			shouldFail: true,
 		},
 		{
@@ -703,6 +1236,21 @@ func TestAssertableString_IsUpperCase(t *testing.T) {
 			actual:     strings.ToUpper("my name is bond"),
 			shouldFail: false,
 		},
+		{
+			name:       "should assert string that is all lower case",
+			actual:     "my name is bond",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert upper case string with digits",
+			actual:     "AGENT-007",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert digits only string",
+			actual:     "007",
+			shouldFail: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -712,3 +1260,2099 @@ func TestAssertableString_IsUpperCase(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertableString_IsValidMACAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert colon separated MAC address",
+			actual:     "00:00:5e:00:53:01",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert hyphen separated MAC address",
+			actual:     "00-00-5E-00-53-01",
+			shouldFail: false,
+		},
+		{
+			name:       "should fail for a malformed MAC address",
+			actual:     "00:00:5e:00:53",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a dotted MAC address",
+			actual:     "0000.5e00.5301",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for an EUI-64 address",
+			actual:     "02:00:5e:10:00:00:00:01",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for an IP over InfiniBand address",
+			actual:     "00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for a non MAC address string",
+			actual:     "not-a-mac",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsValidMACAddress()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsValidBase32(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:       "should assert valid base32 string",
+			actual:     "JBSWY3DPEE======",
+			shouldFail: false,
+		},
+		{
+			name:       "should assert empty string",
+			actual:     "",
+			shouldFail: false,
+		},
+		{
+			name:       "should fail for characters outside of the standard alphabet",
+			actual:     "JBSWY3DP01======",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for missing padding",
+			actual:     "JBSWY3DPEE",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert valid base32 hex string",
+			actual:     "91IMOR3F44======",
+			shouldFail: false,
+			stringOpts: []StringOpt{UsingBase32HexEncoding()},
+		},
+		{
+			name:       "should fail for characters outside of the hex alphabet",
+			actual:     "JBSWY3DPEE======",
+			shouldFail: true,
+			stringOpts: []StringOpt{UsingBase32HexEncoding()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).IsValidBase32()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_Base32DecodesTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:       "should assert decoded value",
+			actual:     "JBSWY3DPEE======",
+			expected:   "Hello!",
+			shouldFail: false,
+		},
+		{
+			name:       "should fail if decoded value is different",
+			actual:     "JBSWY3DPEE======",
+			expected:   "Hello",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid base32 string",
+			actual:     "not base32",
+			expected:   "Hello!",
+			shouldFail: true,
+		},
+		{
+			name:       "should assert decoded value with hex alphabet",
+			actual:     "91IMOR3F44======",
+			expected:   "Hello!",
+			shouldFail: false,
+			stringOpts: []StringOpt{UsingBase32HexEncoding()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).Base32DecodesTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_TrimmedEquals(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should assert string with trailing new line",
+			actual:   "some output\n",
+			expected: "some output",
+		},
+		{
+			name:     "should assert string with leading and trailing white spaces",
+			actual:   " \t some output \t\n",
+			expected: "some output",
+		},
+		{
+			name:       "should fail if inner white spaces differ",
+			actual:     "some  output\n",
+			expected:   "some output",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if expected is not trimmed",
+			actual:     "some output\n",
+			expected:   "some output\n",
+			shouldFail: true,
+		},
+		{
+			name:     "should apply options to the expected string",
+			actual:   " SOME OUTPUT\n",
+			expected: "Some Output",
+			opts:     []StringOpt{IgnoringCase()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).TrimmedEquals(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsEqualToIgnoringWhitespace(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should ignore tabs new lines and repeated spaces",
+			actual:   "SELECT *\n\tFROM  users\r\n\tWHERE id = 1",
+			expected: "SELECT * FROM users WHERE id = 1",
+		},
+		{
+			name:     "should ignore leading and trailing white spaces",
+			actual:   " \t hello world\n\n",
+			expected: "hello   world ",
+		},
+		{
+			name:     "should combine with ignoring case",
+			actual:   "Hello\n\tWORLD",
+			expected: "hello world",
+			opts:     []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for different case without ignoring case",
+			actual:     "Hello\n\tWORLD",
+			expected:   "hello world",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for removed word separation",
+			actual:     "hello world",
+			expected:   "helloworld",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for different words",
+			actual:     "hello\tworld",
+			expected:   "hello there",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).IsEqualToIgnoringWhitespace(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsEqualToIgnoringCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should assert values differing in case",
+			actual:   "Hello World",
+			expected: "hELLO wORLD",
+		},
+		{
+			name:     "should assert values under unicode case folding",
+			actual:   "ΣΊΣΥΦΟΣ",
+			expected: "σίσυφος",
+		},
+		{
+			name:     "should assert combined with other options",
+			actual:   "Hello World",
+			expected: "helloworld",
+			opts:     []StringOpt{IgnoringWhiteSpaces()},
+		},
+		{
+			name:       "should fail for different values",
+			actual:     "Hello World",
+			expected:   "Hello there",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).IsEqualToIgnoringCase(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IgnoringCaseComparisonsKeepValue(t *testing.T) {
+	test := &testing.T{}
+	ThatString(test, "Hello World").
+		IsEqualToIgnoringCase("hello world").
+		ContainsIgnoringCase("WORLD").
+		IsEqualTo("Hello World")
+	ThatBool(t, test.Failed()).IsFalse()
+
+	test = &testing.T{}
+	ThatString(test, "Hello World").IsEqualToIgnoringCase("hello world").IsEqualTo("hello world")
+	ThatBool(t, test.Failed()).IsTrue()
+}
+
+func TestAssertableString_DedentedEquals(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name: "should assert indented literal",
+			actual: `first
+  second
+third`,
+			expected: `
+		first
+		  second
+		third`[1:],
+		},
+		{
+			name:     "should assert both indented",
+			actual:   "    a\n      b\n",
+			expected: "\ta\n\t  b\n",
+		},
+		{
+			name:     "should ignore blank lines for the indentation",
+			actual:   "\t\ta\n\n \n\t\tb",
+			expected: "a\n\n\nb",
+		},
+		{
+			name:     "should assert strings without indentation",
+			actual:   "a\nb",
+			expected: "a\nb",
+		},
+		{
+			name:       "should fail if relative indentation differs",
+			actual:     "  a\n    b",
+			expected:   "a\nb",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if content differs",
+			actual:     "  a\n  b",
+			expected:   "  a\n  c",
+			shouldFail: true,
+		},
+		{
+			name:     "should apply options to the expected string",
+			actual:   "  ABC\n  DEF",
+			expected: "ABC\nDEF",
+			opts:     []StringOpt{IgnoringCase()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).DedentedEquals(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsValidDuration(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert seconds duration",
+			actual: "30s",
+		},
+		{
+			name:   "should assert composite duration",
+			actual: "1h15m30.5s",
+		},
+		{
+			name:   "should assert negative duration",
+			actual: "-2ms",
+		},
+		{
+			name:       "should fail for duration without unit",
+			actual:     "30",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unknown unit",
+			actual:     "3d",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsValidDuration()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_ParsesAsDuration(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   time.Duration
+		shouldFail bool
+	}{
+		{
+			name:     "should parse and assert duration",
+			actual:   "1m30s",
+			expected: 90 * time.Second,
+		},
+		{
+			name:       "should fail if parsed duration is different",
+			actual:     "1m30s",
+			expected:   time.Minute,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid duration",
+			actual:     "thirty seconds",
+			expected:   0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).ParsesAsDuration().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsNumeric(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert negative integer",
+			actual: "-42",
+		},
+		{
+			name:   "should assert decimal",
+			actual: "3.14",
+		},
+		{
+			name:   "should assert signed decimal with exponent",
+			actual: "+1.5e3",
+		},
+		{
+			name:       "should fail for letters",
+			actual:     "abc",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for many decimal points",
+			actual:     "1.2.3",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for infinity",
+			actual:     "Inf",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for NaN",
+			actual:     "NaN",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for empty string",
+			actual:     "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsNumeric()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_AsInt(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should parse negative integer",
+			actual:   "-42",
+			expected: -42,
+		},
+		{
+			name:       "should fail for decimal",
+			actual:     "3.14",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for letters",
+			actual:     "abc",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).AsInt().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_AsFloat64(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   float64
+		shouldFail bool
+	}{
+		{
+			name:     "should parse decimal",
+			actual:   "3.14",
+			expected: 3.14,
+		},
+		{
+			name:     "should parse negative integer",
+			actual:   "-42",
+			expected: -42,
+		},
+		{
+			name:       "should fail for letters",
+			actual:     "abc",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for infinity",
+			actual:     "-Inf",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).AsFloat64().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_MatchesRegexp(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		pattern    string
+		shouldFail bool
+	}{
+		{
+			name:    "should assert unanchored pattern matching part of the string",
+			actual:  "order-1234 created",
+			pattern: `\d{4}`,
+		},
+		{
+			name:    "should assert anchored pattern matching the whole string",
+			actual:  "order-1234",
+			pattern: `^order-\d+$`,
+		},
+		{
+			name:       "should fail for anchored pattern matching only part of the string",
+			actual:     "order-1234 created",
+			pattern:    `^order-\d+$`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for not matching pattern",
+			actual:     "order-abc",
+			pattern:    `\d+`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid pattern",
+			actual:     "order-1234",
+			pattern:    `(\d+`,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).MatchesRegexp(tt.pattern)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_DoesNotMatchRegexp(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		pattern    string
+		shouldFail bool
+	}{
+		{
+			name:    "should assert not matching unanchored pattern",
+			actual:  "order-abc",
+			pattern: `\d+`,
+		},
+		{
+			name:    "should assert anchored pattern matching only part of the string",
+			actual:  "order-1234 created",
+			pattern: `^order-\d+$`,
+		},
+		{
+			name:       "should fail for matching unanchored pattern",
+			actual:     "order-1234 created",
+			pattern:    `\d{4}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid pattern",
+			actual:     "order-abc",
+			pattern:    `[a-`,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).DoesNotMatchRegexp(tt.pattern)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_EachLineMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		pattern    string
+		shouldFail bool
+	}{
+		{
+			name:    "should succeed if every line matches",
+			actual:  "INFO started\nWARN slow request\nINFO stopped",
+			pattern: `^(INFO|WARN|ERROR) `,
+		},
+		{
+			name:    "should ignore empty lines",
+			actual:  "INFO started\n\nINFO stopped\n",
+			pattern: `^INFO `,
+		},
+		{
+			name:       "should fail if a line doesn't match",
+			actual:     "INFO started\npanic: runtime error\nINFO stopped",
+			pattern:    `^(INFO|WARN|ERROR) `,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid pattern",
+			actual:     "INFO started",
+			pattern:    `^(INFO`,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).EachLineMatches(tt.pattern)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_SomeLineMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		pattern    string
+		shouldFail bool
+	}{
+		{
+			name:    "should succeed if a line matches",
+			actual:  "INFO started\nERROR failed\nINFO stopped",
+			pattern: `^ERROR `,
+		},
+		{
+			name:       "should fail if no line matches",
+			actual:     "INFO started\nINFO stopped",
+			pattern:    `^ERROR `,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid pattern",
+			actual:     "INFO started",
+			pattern:    `[`,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).SomeLineMatches(tt.pattern)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_MatchGroupEquals(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		pattern    string
+		groupIndex int
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:       "should assert captured group",
+			actual:     "go-testing version 1.4.2 (stable)",
+			pattern:    `version (\d+)\.(\d+)\.(\d+)`,
+			groupIndex: 2,
+			expected:   "4",
+		},
+		{
+			name:       "should assert whole match",
+			actual:     "go-testing version 1.4.2 (stable)",
+			pattern:    `version \S+`,
+			groupIndex: 0,
+			expected:   "version 1.4.2",
+		},
+		{
+			name:       "should fail if captured group is different",
+			actual:     "go-testing version 1.4.2 (stable)",
+			pattern:    `version (\d+)\.(\d+)\.(\d+)`,
+			groupIndex: 1,
+			expected:   "2",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if pattern doesn't match",
+			actual:     "go-testing (stable)",
+			pattern:    `version (\d+)`,
+			groupIndex: 1,
+			expected:   "1",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if group index is out of range",
+			actual:     "go-testing version 1.4.2 (stable)",
+			pattern:    `version (\d+)`,
+			groupIndex: 2,
+			expected:   "4",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for negative group index",
+			actual:     "go-testing version 1.4.2 (stable)",
+			pattern:    `version (\d+)`,
+			groupIndex: -1,
+			expected:   "1",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid pattern",
+			actual:     "go-testing version 1.4.2 (stable)",
+			pattern:    `version (\d+`,
+			groupIndex: 1,
+			expected:   "1",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).MatchGroupEquals(tt.pattern, tt.groupIndex, tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_MatchesNoneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		patterns   []string
+		shouldFail bool
+	}{
+		{
+			name:     "should succeed if no pattern matches",
+			actual:   "request completed in 20ms",
+			patterns: []string{`panic:`, `goroutine \d+ \[running\]`, `password=\S+`},
+		},
+		{
+			name:     "should succeed for no patterns",
+			actual:   "request completed in 20ms",
+			patterns: []string{},
+		},
+		{
+			name:       "should fail if a pattern matches",
+			actual:     "login with user=admin password=secret",
+			patterns:   []string{`panic:`, `password=\S+`},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid pattern",
+			actual:     "request completed in 20ms",
+			patterns:   []string{`panic:`, `(`},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).MatchesNoneOf(tt.patterns...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_LeadingSpaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   int
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:     "should count leading spaces of the first line",
+			actual:   "    indented\n  less indented",
+			expected: 4,
+		},
+		{
+			name:     "should count no leading spaces",
+			actual:   "not indented\n    indented",
+			expected: 0,
+		},
+		{
+			name:     "should count tab as single character by default",
+			actual:   "\t  indented",
+			expected: 3,
+		},
+		{
+			name:       "should count tab using the tab width",
+			actual:     "\t  indented",
+			expected:   6,
+			stringOpts: []StringOpt{WithTabWidth(4)},
+		},
+		{
+			name:       "should fail for different indentation",
+			actual:     "  indented",
+			expected:   4,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).LeadingSpaces().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_LeadingSpacesOfLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		line       int
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should count leading spaces of the given line",
+			actual:   "func main() {\n    fmt.Println()\n}",
+			line:     2,
+			expected: 4,
+		},
+		{
+			name:     "should count leading spaces of the last line",
+			actual:   "func main() {\n    fmt.Println()\n}",
+			line:     3,
+			expected: 0,
+		},
+		{
+			name:       "should fail for line out of range",
+			actual:     "func main() {\n    fmt.Println()\n}",
+			line:       4,
+			expected:   0,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero line",
+			actual:     "func main() {}",
+			line:       0,
+			expected:   0,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).LeadingSpacesOfLine(tt.line).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_ByteAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		index      int
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should return first byte",
+			actual:   "abc",
+			index:    0,
+			expected: 'a',
+		},
+		{
+			name:     "should return last byte",
+			actual:   "abc",
+			index:    2,
+			expected: 'c',
+		},
+		{
+			name:     "should return part of multi-byte character",
+			actual:   "café",
+			index:    3,
+			expected: 0xc3,
+		},
+		{
+			name:       "should fail for index out of bounds",
+			actual:     "abc",
+			index:      3,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for negative index",
+			actual:     "abc",
+			index:      -1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).ByteAt(tt.index).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_RuneAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		index      int
+		expected   rune
+		shouldFail bool
+	}{
+		{
+			name:     "should return first rune",
+			actual:   "abc",
+			index:    0,
+			expected: 'a',
+		},
+		{
+			name:     "should index multi-byte character by rune position",
+			actual:   "café",
+			index:    3,
+			expected: 'é',
+		},
+		{
+			name:     "should return emoji rune",
+			actual:   "ok 👍",
+			index:    3,
+			expected: '👍',
+		},
+		{
+			name:       "should fail for byte length index",
+			actual:     "café",
+			index:      4,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for negative index",
+			actual:     "abc",
+			index:      -1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).RuneAt(tt.index).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_HashEquals(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:     "should assert SHA-256 hash by default",
+			actual:   "hello world",
+			expected: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		},
+		{
+			name:     "should assert upper case hash",
+			actual:   "hello world",
+			expected: "B94D27B9934D3E08A52E52D7DA7DABFAC484EFE37A5380EE9088F7ACE2EFCDE9",
+		},
+		{
+			name:       "should assert SHA-1 hash",
+			actual:     "hello world",
+			expected:   "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed",
+			stringOpts: []StringOpt{HashingWith(crypto.SHA1)},
+		},
+		{
+			name:       "should fail for different hash",
+			actual:     "hello world!",
+			expected:   "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unavailable hash function",
+			actual:     "hello world",
+			expected:   "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+			shouldFail: true,
+			stringOpts: []StringOpt{HashingWith(crypto.MD4)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).HashEquals(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_EditDistanceTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		other      string
+		expected   int
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:     "should compute distance of equal strings",
+			actual:   "kitten",
+			other:    "kitten",
+			expected: 0,
+		},
+		{
+			name:     "should compute distance of different strings",
+			actual:   "kitten",
+			other:    "sitting",
+			expected: 3,
+		},
+		{
+			name:     "should compute distance to empty string",
+			actual:   "",
+			other:    "abc",
+			expected: 3,
+		},
+		{
+			name:     "should compute distance in runes",
+			actual:   "café",
+			other:    "cafe",
+			expected: 1,
+		},
+		{
+			name:       "should compute distance ignoring case",
+			actual:     "Kitten",
+			other:      "kITTEN",
+			expected:   0,
+			stringOpts: []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for different distance",
+			actual:     "flaw",
+			other:      "lawn",
+			expected:   1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).EditDistanceTo(tt.other).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_ColumnAt(t *testing.T) {
+	record := "0001JOHN      SMITH     00042"
+	tests := []struct {
+		name       string
+		actual     string
+		start, end int
+		trim       bool
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should extract the first field",
+			actual:   record,
+			start:    0,
+			end:      4,
+			expected: "0001",
+		},
+		{
+			name:     "should extract a padded field",
+			actual:   record,
+			start:    4,
+			end:      14,
+			expected: "JOHN      ",
+		},
+		{
+			name:     "should extract and trim a padded field",
+			actual:   record,
+			start:    14,
+			end:      24,
+			trim:     true,
+			expected: "SMITH",
+		},
+		{
+			name:     "should extract the last field",
+			actual:   record,
+			start:    24,
+			end:      29,
+			expected: "00042",
+		},
+		{
+			name:       "should fail for range out of bounds",
+			actual:     record,
+			start:      24,
+			end:        30,
+			expected:   "",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for reversed range",
+			actual:     record,
+			start:      4,
+			end:        2,
+			expected:   "",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for negative start",
+			actual:     record,
+			start:      -1,
+			end:        2,
+			expected:   "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).ColumnAt(tt.start, tt.end, tt.trim).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsValidCron(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:   "should assert wildcards",
+			actual: "* * * * *",
+		},
+		{
+			name:   "should assert values, ranges, steps and lists",
+			actual: "*/15 9-17 1,15 1-12/3 1-5",
+		},
+		{
+			name:   "should assert month and day of week names",
+			actual: "0 0 * jan,JUL MON-FRI",
+		},
+		{
+			name:   "should assert Sunday as 7",
+			actual: "0 0 * * 7",
+		},
+		{
+			name:       "should assert expression with seconds",
+			actual:     "30 */5 * * * *",
+			stringOpts: []StringOpt{WithCronSeconds()},
+		},
+		{
+			name:       "should fail for missing fields",
+			actual:     "* * * *",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for seconds field without the option",
+			actual:     "30 */5 * * * *",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for value out of range",
+			actual:     "0 24 * * *",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for reversed range",
+			actual:     "0 0 20-10 * *",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero step",
+			actual:     "*/0 * * * *",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unknown name",
+			actual:     "0 0 * * FUN",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for seconds out of range",
+			actual:     "60 * * * * *",
+			shouldFail: true,
+			stringOpts: []StringOpt{WithCronSeconds()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).IsValidCron()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsValidHexColor(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+		stringOpts []StringOpt
+	}{
+		{
+			name:   "should assert #RGB color",
+			actual: "#fA0",
+		},
+		{
+			name:   "should assert #RRGGBB color",
+			actual: "#FFaa00",
+		},
+		{
+			name:   "should assert #RRGGBBAA color",
+			actual: "#ffaa0080",
+		},
+		{
+			name:       "should assert color without hash if optional",
+			actual:     "ffaa00",
+			stringOpts: []StringOpt{WithOptionalHexColorHash()},
+		},
+		{
+			name:       "should assert color with hash if optional",
+			actual:     "#ffaa00",
+			stringOpts: []StringOpt{WithOptionalHexColorHash()},
+		},
+		{
+			name:       "should fail for color without hash",
+			actual:     "ffaa00",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid length",
+			actual:     "#ffaa0",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for non hex digits",
+			actual:     "#ffaa0g",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).IsValidHexColor()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_HexColorComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   []int
+		shouldFail bool
+	}{
+		{
+			name:     "should extract expanded #RGB components",
+			actual:   "#fa0",
+			expected: []int{255, 170, 0},
+		},
+		{
+			name:     "should extract #RRGGBBAA components",
+			actual:   "#10203040",
+			expected: []int{16, 32, 48, 64},
+		},
+		{
+			name:       "should fail for invalid color",
+			actual:     "#10203",
+			expected:   []int{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).HexColorComponents().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_NormalizingLineEndings(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		stringOpts []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:       "should assert CRLF text equal to LF text",
+			actual:     "line1\r\nline2\r\n",
+			expected:   "line1\nline2\n",
+			stringOpts: []StringOpt{NormalizingLineEndings()},
+		},
+		{
+			name:       "should assert CR text equal to CRLF text",
+			actual:     "line1\rline2",
+			expected:   "line1\r\nline2",
+			stringOpts: []StringOpt{NormalizingLineEndings()},
+		},
+		{
+			name:       "should compose with other decorators",
+			actual:     "LINE1\r\nLINE2",
+			expected:   "line1\nline2",
+			stringOpts: []StringOpt{NormalizingLineEndings(), IgnoringCase()},
+		},
+		{
+			name:       "should fail for different text",
+			actual:     "line1\r\nline2",
+			expected:   "line1\nline3",
+			stringOpts: []StringOpt{NormalizingLineEndings()},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail without normalizing",
+			actual:     "line1\r\nline2",
+			expected:   "line1\nline2",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_NormalizingLineEndings_Size(t *testing.T) {
+	test := &testing.T{}
+	ThatString(test, "a\r\nb\r\n", NormalizingLineEndings()).HasSameSizeAs("a\nb\n")
+	ThatBool(t, test.Failed()).IsFalse()
+}
+
+func TestAssertableString_IsAnagramOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		other      string
+		stringOpts []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:   "should assert anagram",
+			actual: "listen",
+			other:  "silent",
+		},
+		{
+			name:       "should assert phrase anagram ignoring case, spaces and punctuation",
+			actual:     "Dormitory",
+			other:      "Dirty room!",
+			stringOpts: []StringOpt{IgnoringCase(), IgnoringWhiteSpaces(), IgnoringPunctuation()},
+		},
+		{
+			name:       "should fail for phrase anagram without options",
+			actual:     "Dormitory",
+			other:      "Dirty room!",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if rune counts differ",
+			actual:     "aab",
+			other:      "abb",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail if lengths differ",
+			actual:     "abc",
+			other:      "abcd",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.stringOpts...).IsAnagramOf(tt.other)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsValidXML(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert valid XML",
+			actual: `<?xml version="1.0"?><order id="1"><item qty="2">book</item></order>`,
+		},
+		{
+			name:       "should fail for unclosed element",
+			actual:     `<order><item></order>`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for text outside of the root element",
+			actual:     `not xml`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for multiple root elements",
+			actual:     `<a/><b/>`,
+			shouldFail: true,
+		},
+		{
+			name:   "should assert comment after the root element",
+			actual: `<a/><!-- done -->`,
+		},
+		{
+			name:       "should fail for empty string",
+			actual:     "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsValidXML()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsXMLEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should assert equal XML ignoring whitespace and attribute order",
+			actual:   "<order id=\"1\" status=\"new\">\n  <item>book</item>\n</order>",
+			expected: `<order status="new" id="1"><item> book </item></order>`,
+		},
+		{
+			name:     "should assert equal XML ignoring comments",
+			actual:   `<order><!-- note --><item/></order>`,
+			expected: `<order><item></item></order>`,
+		},
+		{
+			name:       "should fail for different attribute values",
+			actual:     `<order id="1"/>`,
+			expected:   `<order id="2"/>`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for different element order",
+			actual:     `<order><a/><b/></order>`,
+			expected:   `<order><b/><a/></order>`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid expected XML",
+			actual:     `<order/>`,
+			expected:   `<order>`,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsXMLEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsValidJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert object",
+			actual: `{"id": 1, "tags": ["a", "b"], "owner": null}`,
+		},
+		{
+			name:   "should assert scalar",
+			actual: ` "text" `,
+		},
+		{
+			name:       "should fail for trailing comma",
+			actual:     `{"id": 1,}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for trailing data",
+			actual:     `{"id": 1} {"id": 2}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for empty string",
+			actual:     "",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsValidJSON()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_HasJSONEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should assert documents with reordered keys",
+			actual:   `{"id": 1, "user": {"name": "jo", "admin": false}}`,
+			expected: `{"user": {"admin": false, "name": "jo"}, "id": 1}`,
+		},
+		{
+			name:     "should assert documents with differing whitespace",
+			actual:   "{\n\t\"tags\": [ \"a\",\n \"b\" ]\n}",
+			expected: `{"tags":["a","b"]}`,
+		},
+		{
+			name:     "should assert equal numbers in different notation",
+			actual:   `{"ratio": 1.0}`,
+			expected: `{"ratio": 1e0}`,
+		},
+		{
+			name:       "should fail for reordered array elements",
+			actual:     `{"tags": ["a", "b"]}`,
+			expected:   `{"tags": ["b", "a"]}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for different value",
+			actual:     `{"id": 1}`,
+			expected:   `{"id": "1"}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for missing key",
+			actual:     `{"id": 1}`,
+			expected:   `{"id": 1, "name": "jo"}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid actual",
+			actual:     `{"id": }`,
+			expected:   `{"id": 1}`,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid expected",
+			actual:     `{"id": 1}`,
+			expected:   `{id: 1}`,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).HasJSONEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_WordCountMatching(t *testing.T) {
+	isCapitalized := func(word string) bool {
+		return unicode.IsUpper([]rune(word)[0])
+	}
+	tests := []struct {
+		name       string
+		actual     string
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should count matching words",
+			actual:   "The quick Brown fox\tjumps over\nLazy dogs",
+			expected: 3,
+		},
+		{
+			name:     "should count zero for empty string",
+			actual:   "",
+			expected: 0,
+		},
+		{
+			name:       "should fail for different count",
+			actual:     "The quick brown fox",
+			expected:   2,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).WordCountMatching(isCapitalized).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_HasMinEntropyBits(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		bits       float64
+		shouldFail bool
+	}{
+		{
+			name:   "should assert string of distinct characters",
+			actual: "abcd",
+			bits:   8,
+		},
+		{
+			name:   "should assert random looking password",
+			actual: "x7#Kq9!mZ2",
+			bits:   33,
+		},
+		{
+			name:       "should fail for repeated character",
+			actual:     "aaaaaaaa",
+			bits:       1,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for low entropy string",
+			actual:     "aab",
+			bits:       3,
+			shouldFail: true,
+		},
+		{
+			name:   "should assert zero bits for empty string",
+			actual: "",
+			bits:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).HasMinEntropyBits(tt.bits)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsConstantTimeEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should assert equal strings",
+			actual:   "s3cr3t-t0k3n",
+			expected: "s3cr3t-t0k3n",
+		},
+		{
+			name:     "should assert empty strings",
+			actual:   "",
+			expected: "",
+		},
+		{
+			name:       "should fail for different strings of same length",
+			actual:     "s3cr3t-t0k3n",
+			expected:   "s3cr3t-t0k3N",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for different lengths",
+			actual:     "s3cr3t",
+			expected:   "s3cr3t-t0k3n",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsConstantTimeEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_CommonPrefixWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		other      string
+		expected   string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should return common path prefix",
+			actual:   "/var/log/app.log",
+			other:    "/var/lib/app",
+			expected: "/var/l",
+		},
+		{
+			name:     "should not split multi-byte characters",
+			actual:   "naïve",
+			other:    "naïf",
+			expected: "naï",
+		},
+		{
+			name:     "should return empty prefix",
+			actual:   "abc",
+			other:    "xyz",
+			expected: "",
+		},
+		{
+			name:     "should return shorter string as prefix",
+			actual:   "abc",
+			other:    "ab",
+			expected: "ab",
+		},
+		{
+			name:       "should fail for different prefix",
+			actual:     "abc",
+			other:      "abd",
+			expected:   "abc",
+			shouldFail: true,
+		},
+		{
+			name:     "should apply options to the other string",
+			actual:   "ABC",
+			other:    "ABD",
+			opts:     []StringOpt{IgnoringCase()},
+			expected: "ab",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).CommonPrefixWith(tt.other).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_EqualsWithMask(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		maskChar   rune
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should match masked positions",
+			actual:   "abXYef",
+			expected: "ab**ef",
+			maskChar: '*',
+		},
+		{
+			name:     "should match without masked positions",
+			actual:   "abcdef",
+			expected: "abcdef",
+			maskChar: '*',
+		},
+		{
+			name:     "should match multi-byte characters rune by rune",
+			actual:   "café-42",
+			expected: "caf#-##",
+			maskChar: '#',
+		},
+		{
+			name:     "should match empty strings",
+			maskChar: '*',
+		},
+		{
+			name:       "should fail for mismatch at unmasked position",
+			actual:     "abXYeg",
+			expected:   "ab**ef",
+			maskChar:   '*',
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for shorter actual",
+			actual:     "abXe",
+			expected:   "ab**ef",
+			maskChar:   '*',
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for longer actual",
+			actual:     "abXYefg",
+			expected:   "ab**ef",
+			maskChar:   '*',
+			shouldFail: true,
+		},
+		{
+			name:     "should apply options to the expected string",
+			actual:   "ABXYEF",
+			expected: "AB**EF",
+			maskChar: '*',
+			opts:     []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for mismatch at unmasked position with options",
+			actual:     "ABXYEG",
+			expected:   "AB**EF",
+			maskChar:   '*',
+			opts:       []StringOpt{IgnoringCase()},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).EqualsWithMask(tt.expected, tt.maskChar)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_ContainsValidMarkdownLinks(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert valid links",
+			actual: "See [the docs](https://example.com/docs) and [changelog](./CHANGELOG.md#v1).",
+		},
+		{
+			name:   "should assert text without links",
+			actual: "No links [here] or (there).",
+		},
+		{
+			name:       "should fail for empty link text",
+			actual:     "See [ ](https://example.com).",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for empty url",
+			actual:     "See [the docs]().",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unparsable url",
+			actual:     "See [the docs](https://example.com/%zz).",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).ContainsValidMarkdownLinks()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_IsRedacted(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		patterns   []string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert redacted value",
+			actual: "user=***** card=**** **** **** 1234 ssn=***-**-****",
+		},
+		{
+			name:       "should fail for credit card number",
+			actual:     "card=4111 1111 1111 1111",
+			shouldFail: true,
+		},
+		{
+			name:   "should assert unix millisecond timestamp failing the Luhn checksum",
+			actual: "ts=1700000000001",
+		},
+		{
+			name:   "should assert card shaped number failing the Luhn checksum",
+			actual: "card=4111 1111 1111 1112",
+		},
+		{
+			name:       "should fail for credit card number after other long number",
+			actual:     "ts=1700000000001 card=5500-0000-0000-0004",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for social security number",
+			actual:     "ssn=123-45-6789",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for email address",
+			actual:     "user=jane.doe@example.com",
+			shouldFail: true,
+		},
+		{
+			name:     "should assert redacted value with custom pattern",
+			actual:   "token=[REDACTED]",
+			patterns: []string{`token=[a-f0-9]{8,}`},
+		},
+		{
+			name:       "should fail for custom pattern match",
+			actual:     "token=deadbeef42",
+			patterns:   []string{`token=[a-f0-9]{8,}`},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for invalid custom pattern",
+			actual:     "token=[REDACTED]",
+			patterns:   []string{`token=(`},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual).IsRedacted(tt.patterns...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_And(t *testing.T) {
+	test := &testing.T{}
+	assertable := ThatString(test, "abc", IgnoringCase()).WithMessage("name")
+	ThatStruct(t, assertable.And()).IsEqualTo(assertable)
+	ThatStruct(t, assertable.Also()).IsEqualTo(assertable)
+
+	assertable.IsNotEmpty().And().StartsWith("a").Also().EndsWith("c")
+	ThatBool(t, test.Failed()).IsFalse()
+	assertable.IsNotEmpty().And().StartsWith("b")
+	ThatBool(t, test.Failed()).IsTrue()
+}
+
+func TestAssertableString_HasPrefixOneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		prefixes   []string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should assert matching one of multiple prefixes",
+			actual:   "https://example.com",
+			prefixes: []string{"ftp://", "http://", "https://"},
+		},
+		{
+			name:     "should assert matching prefix ignoring case",
+			actual:   "HTTPS://example.com",
+			prefixes: []string{"http://", "https://"},
+			opts:     []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for prefix differing in case",
+			actual:     "HTTPS://example.com",
+			prefixes:   []string{"http://", "https://"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for no matching prefix",
+			actual:     "file:///tmp",
+			prefixes:   []string{"http://", "https://"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for empty list",
+			actual:     "https://example.com",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).HasPrefixOneOf(tt.prefixes...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_HasSuffixOneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		suffixes   []string
+		opts       []StringOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should assert matching one of multiple suffixes",
+			actual:   "report.csv",
+			suffixes: []string{".json", ".csv", ".xml"},
+		},
+		{
+			name:     "should assert matching suffix ignoring case",
+			actual:   "REPORT.CSV",
+			suffixes: []string{".json", ".csv"},
+			opts:     []StringOpt{IgnoringCase()},
+		},
+		{
+			name:       "should fail for no matching suffix",
+			actual:     "report.txt",
+			suffixes:   []string{".json", ".csv"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for empty list",
+			actual:     "report.csv",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatString(test, tt.actual, tt.opts...).HasSuffixOneOf(tt.suffixes...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableString_HasPrefixOneOf_ListsCandidates(t *testing.T) {
+	test := &recordingT{}
+	thatString(assertion{t: test}, "file:///tmp").HasPrefixOneOf("http://", "https://")
+	ThatSlice(t, test.errors).HasSize(1)
+	ThatString(t, test.errors[0]).Contains(`one of ["http://" "https://"]`)
+}
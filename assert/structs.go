@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
@@ -8,16 +9,20 @@ import (
 
 // AssertableStruct is the implementation of AssertableAny for structs.
 type AssertableStruct struct {
+	assertion
 	actual values.StructValue
-	t      *testing.T
 }
 
 // ThatStruct returns a proper assertable structure based on the slice type.
 func ThatStruct(t *testing.T, actual interface{}) AssertableStruct {
 	t.Helper()
+	return thatStruct(assertion{t: t}, actual)
+}
+
+func thatStruct(a assertion, actual interface{}) AssertableStruct {
 	return AssertableStruct{
-		actual: values.NewStructValue(actual),
-		t:      t,
+		actual:    values.NewStructValue(actual),
+		assertion: a,
 	}
 }
 
@@ -25,7 +30,7 @@ func ThatStruct(t *testing.T, actual interface{}) AssertableStruct {
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (s AssertableStruct) IsEqualTo(expected interface{}) AssertableStruct {
 	if !s.actual.IsEqualTo(expected) {
-		s.t.Error(shouldBeEqual(s.actual, expected))
+		s.error(shouldBeEqual(s.actual, expected))
 	}
 	return s
 }
@@ -34,7 +39,7 @@ func (s AssertableStruct) IsEqualTo(expected interface{}) AssertableStruct {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (s AssertableStruct) IsNotEqualTo(expected interface{}) AssertableStruct {
 	if s.actual.IsEqualTo(expected) {
-		s.t.Error(shouldNotBeEqual(s.actual, expected))
+		s.error(shouldNotBeEqual(s.actual, expected))
 	}
 	return s
 }
@@ -44,3 +49,56 @@ func (s AssertableStruct) ExcludingFields(fields ...string) AssertableStruct {
 	s.actual.ExcludedFields = fields
 	return s
 }
+
+// HasField asserts if the assertable structure has an exported field with the given name. Fields of nested structs are
+// named by their path, for example "Address.City". Fields promoted from embedded structs can be named either directly,
+// for example "City", or through the embedded struct, for example "Address.City", the form used in error messages.
+// Unexported fields are skipped, so they are never found.
+// It errors the tests if the structure has no such field.
+func (s AssertableStruct) HasField(name string) AssertableStruct {
+	if _, ok := s.actual.Field(name); !ok {
+		s.error(shouldHaveField(s.actual, name))
+	}
+	return s
+}
+
+// HasFieldWithValue asserts if the assertable structure has an exported field with the given name, named as in
+// HasField, that is deeply equal to the given value.
+// It errors the tests if the structure has no such field or if the field has a different value.
+func (s AssertableStruct) HasFieldWithValue(name string, value interface{}) AssertableStruct {
+	field, ok := s.actual.Field(name)
+	if !ok {
+		s.error(shouldHaveField(s.actual, name))
+		return s
+	}
+	if !reflect.DeepEqual(field, value) {
+		s.error(shouldHaveFieldWithValue(s.actual, name, value, field))
+	}
+	return s
+}
+
+// IsEqualToIgnoringFields asserts if the assertable structure is equal to the expected structure after setting the
+// given fields, named as in HasField, of copies of both to their zero value, so fields such as timestamps or generated
+// ids can be ignored. Fields of nested structs held through pointers can't be ignored, so that the compared values are
+// left unchanged. The exported fields are compared with reflect.DeepEqual and unexported fields are skipped.
+// It errors the tests if the structures are of different types or if any of the compared fields differs, naming the
+// first different field.
+func (s AssertableStruct) IsEqualToIgnoringFields(expected interface{}, fields ...string) AssertableStruct {
+	if field, different := s.actual.FirstDifferentField(expected, fields...); different {
+		s.error(shouldBeEqualIgnoringFields(s.actual, expected, fields, field))
+	}
+	return s
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (s AssertableStruct) WithMessage(message string) AssertableStruct {
+	s.customMessage = message
+	return s
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (s AssertableStruct) AsRequirement() AssertableStruct {
+	s.requirement = true
+	return s
+}
@@ -1,6 +1,9 @@
 package assert
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 type randomStruct struct {
 	Field1  string
@@ -312,3 +315,286 @@ func TestAssertableStruct(t *testing.T) {
 		})
 	}
 }
+
+type address struct {
+	City    string
+	Zip     string
+	country string
+}
+
+type user struct {
+	ID      int
+	Name    string
+	Address address
+	Manager *user
+	Tags    []string
+	secret  string
+}
+
+func TestAssertableStruct_HasField(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     interface{}
+		field      string
+		shouldFail bool
+	}{
+		{
+			name:   "should assert exported field",
+			actual: user{},
+			field:  "Name",
+		},
+		{
+			name:   "should assert field of nested struct",
+			actual: user{},
+			field:  "Address.City",
+		},
+		{
+			name:   "should assert field through pointer",
+			actual: &user{Manager: &user{}},
+			field:  "Manager.Name",
+		},
+		{
+			name:       "should fail for field through nil pointer",
+			actual:     user{},
+			field:      "Manager.Name",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unexported field",
+			actual:     user{},
+			field:      "secret",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unexported field of nested struct",
+			actual:     user{},
+			field:      "Address.country",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for missing field",
+			actual:     user{},
+			field:      "Email",
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for non struct value",
+			actual:     "not-a-struct",
+			field:      "Name",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatStruct(test, tt.actual).HasField(tt.field)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableStruct_HasFieldWithValue(t *testing.T) {
+	actual := user{
+		ID:      1,
+		Name:    "John",
+		Address: address{City: "Athens"},
+		Tags:    []string{"admin"},
+		secret:  "s3cr3t",
+	}
+	tests := []struct {
+		name       string
+		field      string
+		value      interface{}
+		shouldFail bool
+	}{
+		{
+			name:  "should assert field value",
+			field: "Name",
+			value: "John",
+		},
+		{
+			name:  "should assert deeply equal field value",
+			field: "Tags",
+			value: []string{"admin"},
+		},
+		{
+			name:  "should assert field value of nested struct",
+			field: "Address.City",
+			value: "Athens",
+		},
+		{
+			name:       "should fail for different field value",
+			field:      "ID",
+			value:      2,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for field value of different type",
+			field:      "ID",
+			value:      int64(1),
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for unexported field",
+			field:      "secret",
+			value:      "s3cr3t",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatStruct(test, actual).HasFieldWithValue(tt.field, tt.value)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableStruct_IsEqualToIgnoringFields(t *testing.T) {
+	manager := &user{ID: 10, Name: "Jane"}
+	actual := user{
+		ID:      1,
+		Name:    "John",
+		Address: address{City: "Athens", Zip: "10558", country: "GR"},
+		Manager: manager,
+		secret:  "s3cr3t",
+	}
+	tests := []struct {
+		name       string
+		expected   interface{}
+		fields     []string
+		shouldFail bool
+	}{
+		{
+			name:     "should assert equal structs ignoring top level field",
+			expected: user{ID: 2, Name: "John", Address: address{City: "Athens", Zip: "10558"}, Manager: manager},
+			fields:   []string{"ID"},
+		},
+		{
+			name:     "should assert equal structs ignoring field of nested struct",
+			expected: user{ID: 1, Name: "John", Address: address{City: "Athens", Zip: "00000"}, Manager: manager},
+			fields:   []string{"Address.Zip"},
+		},
+		{
+			name:     "should assert equal structs ignoring unexported field differences",
+			expected: user{ID: 1, Name: "John", Address: address{City: "Athens", Zip: "10558", country: "US"}, Manager: manager, secret: "other"},
+		},
+		{
+			name:     "should assert pointer to equal struct",
+			expected: &user{ID: 3, Name: "John", Address: address{City: "Athens", Zip: "10558"}, Manager: manager},
+			fields:   []string{"ID"},
+		},
+		{
+			name:       "should fail for different field of nested struct",
+			expected:   user{ID: 1, Name: "John", Address: address{City: "Patras", Zip: "10558"}, Manager: manager},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for field of struct held through pointer",
+			expected:   user{ID: 1, Name: "John", Address: address{City: "Athens", Zip: "10558"}, Manager: &user{ID: 11, Name: "Jane"}},
+			fields:     []string{"Manager.ID"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for struct of different type",
+			expected:   address{City: "Athens"},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for non struct value",
+			expected:   "not-a-struct",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatStruct(test, actual).IsEqualToIgnoringFields(tt.expected, tt.fields...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+	ThatInt(t, actual.ID).IsEqualTo(1)
+	ThatInt(t, manager.ID).IsEqualTo(10)
+}
+
+func TestAssertableStruct_IsEqualToIgnoringFields_NamesField(t *testing.T) {
+	test := &recordingT{}
+	actual := user{ID: 1, Address: address{City: "Athens"}}
+	expected := user{ID: 2, Address: address{City: "Patras"}}
+	thatStruct(assertion{t: test}, actual).IsEqualToIgnoringFields(expected, "ID")
+	ThatSlice(t, test.errors).HasSize(1)
+	ThatString(t, test.errors[0]).Contains("field Address.City is different")
+}
+
+func TestAssertableStruct_IsEqualToIgnoringFields_OpaqueStructFields(t *testing.T) {
+	type record struct {
+		ID      int
+		Created time.Time
+	}
+	tests := []struct {
+		name       string
+		expected   record
+		fields     []string
+		shouldFail bool
+	}{
+		{
+			name:     "should assert equal time fields",
+			expected: record{ID: 2, Created: time.Unix(0, 0)},
+			fields:   []string{"ID"},
+		},
+		{
+			name:     "should assert ignored time field",
+			expected: record{ID: 1, Created: time.Unix(1000, 0)},
+			fields:   []string{"Created"},
+		},
+		{
+			name:       "should fail for different time field",
+			expected:   record{ID: 1, Created: time.Unix(1000, 0)},
+			fields:     []string{"ID"},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatStruct(test, record{ID: 1, Created: time.Unix(0, 0)}).IsEqualToIgnoringFields(tt.expected, tt.fields...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+type Embedded struct {
+	X int
+}
+
+type withEmbedded struct {
+	Embedded
+	Y int
+}
+
+type withEmbeddedPointer struct {
+	*Embedded
+	Y int
+}
+
+func TestAssertableStruct_EmbeddedFields(t *testing.T) {
+	test := &testing.T{}
+	ThatStruct(test, withEmbedded{Embedded: Embedded{X: 1}, Y: 2}).
+		HasField("X").
+		HasField("Embedded.X").
+		HasFieldWithValue("X", 1).
+		HasFieldWithValue("Embedded.X", 1).
+		IsEqualToIgnoringFields(withEmbedded{Embedded: Embedded{X: 3}, Y: 2}, "X")
+	ThatStruct(test, withEmbeddedPointer{Embedded: &Embedded{X: 1}}).HasFieldWithValue("X", 1)
+	ThatBool(t, test.Failed()).IsFalse()
+
+	test = &testing.T{}
+	ThatStruct(test, withEmbeddedPointer{}).HasField("X")
+	ThatBool(t, test.Failed()).IsTrue()
+
+	recording := &recordingT{}
+	thatStruct(assertion{t: recording}, withEmbedded{Embedded: Embedded{X: 1}}).
+		IsEqualToIgnoringFields(withEmbedded{Embedded: Embedded{X: 3}})
+	ThatSlice(t, recording.errors).HasSize(1)
+	ThatString(t, recording.errors[0]).Contains("field Embedded.X is different")
+}
@@ -32,6 +32,11 @@ func (t FluentT) AssertThatInt(actual int) AssertableInt {
 	return ThatInt(t.t, actual)
 }
 
+// AssertThatFloat64 initializes an assertable float64 to be used for asserting float64 properties.
+func (t FluentT) AssertThatFloat64(actual float64, opts ...FloatOpt) AssertableFloat64 {
+	return ThatFloat64(t.t, actual, opts...)
+}
+
 // AssertThatSlice initializes an assertable slice to be used for asserting slice properties.
 func (t FluentT) AssertThatSlice(actual interface{}, opts ...SliceOpt) AssertableSlice {
 	return ThatSlice(t.t, actual, opts...)
@@ -43,8 +48,8 @@ func (t FluentT) AssertThatStruct(actual interface{}) AssertableStruct {
 }
 
 // AssertThatTime initializes an assertable time.Time to be used for asserting time.Time properties.
-func (t FluentT) AssertThatTime(actual time.Time) AssertableTime {
-	return ThatTime(t.t, actual)
+func (t FluentT) AssertThatTime(actual time.Time, opts ...TimeOpt) AssertableTime {
+	return ThatTime(t.t, actual, opts...)
 }
 
 // AssertThatDuration initializes an assertable time.Duration to be used for asserting time.Duration properties.
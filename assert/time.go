@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -9,24 +10,82 @@ import (
 
 // AssertableTime is the assertable structure for time.Time values.
 type AssertableTime struct {
-	t      *testing.T
-	actual types.TimeValue
+	assertion
+	actual   types.TimeValue
+	location *time.Location
+	clock    func() time.Time
+}
+
+// TimeOpt is a configuration option to initialize an AssertableTime.
+type TimeOpt func(*AssertableTime)
+
+// WithLocation sets the location used by the calendar based assertions to determine day boundaries.
+// If not set, the location of the compared time.Time values is used.
+func WithLocation(location *time.Location) TimeOpt {
+	return func(c *AssertableTime) {
+		c.location = location
+	}
+}
+
+// WithClock sets the function returning the current time used by the assertions relative to now, so they can be
+// tested deterministically. If not set or nil, time.Now is used.
+func WithClock(clock func() time.Time) TimeOpt {
+	return func(c *AssertableTime) {
+		if clock != nil {
+			c.clock = clock
+		}
+	}
 }
 
 // ThatTime returns an AssertableTime structure initialized with the test reference and the actual value to assert.
-func ThatTime(t *testing.T, actual time.Time) AssertableTime {
+func ThatTime(t *testing.T, actual time.Time, opts ...TimeOpt) AssertableTime {
 	t.Helper()
-	return AssertableTime{
-		t:      t,
-		actual: types.NewTimeValue(actual),
+	return thatTime(assertion{t: t}, actual, opts...)
+}
+
+func thatTime(a assertion, actual time.Time, opts ...TimeOpt) AssertableTime {
+	assertable := &AssertableTime{
+		assertion: a,
+		actual:    types.NewTimeValue(actual),
+		clock:     time.Now,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(assertable)
+		}
 	}
+	return *assertable
+}
+
+// ThatTimeString parses the given value using the layout and returns an AssertableTime structure initialized with the
+// test reference and the parsed time value to assert.
+// It errors the test if the value can't be parsed and the returned structure holds the zero time value.
+func ThatTimeString(t *testing.T, value, layout string, opts ...TimeOpt) AssertableTime {
+	t.Helper()
+	actual, err := time.Parse(layout, value)
+	if err != nil {
+		t.Error(shouldBeParsableTime(value, layout, err))
+		return ThatTime(t, time.Time{}, opts...)
+	}
+	return ThatTime(t, actual, opts...)
 }
 
 // IsSameAs asserts if the expected time.Time is equal to the assertable time.Time value
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableTime) IsSameAs(expected time.Time) AssertableTime {
 	if a.actual.IsNotSameAs(expected) {
-		a.t.Error(shouldBeEqual(a.actual, expected))
+		a.error(shouldBeEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsSameInstantAs asserts if the expected time.Time represents the same instant as the assertable time.Time value
+// Unlike IsSameAs, which also compares the location and the monotonic clock reading, the two values are compared using
+// time.Time.Equal so the same instant in different time zones is considered the same.
+// It errors the tests if the compared values (actual VS expected) are not the same instant.
+func (a AssertableTime) IsSameInstantAs(expected time.Time) AssertableTime {
+	if !a.actual.IsSameInstantAs(expected) {
+		a.error(shouldBeSameInstant(a.actual, expected))
 	}
 	return a
 }
@@ -35,7 +94,17 @@ func (a AssertableTime) IsSameAs(expected time.Time) AssertableTime {
 // It errors the tests if the compared values (actual VS expected) are not equal.
 func (a AssertableTime) IsAlmostSameAs(expected time.Time) AssertableTime {
 	if !a.actual.IsAlmostSameAs(expected) {
-		a.t.Error(shouldBeAlmostSame(a.actual, expected))
+		a.error(shouldBeAlmostSame(a.actual, expected))
+	}
+	return a
+}
+
+// IsWithinDuration asserts if the assertable time.Time value differs from the expected value by at most the given
+// delta, in either direction.
+// It errors the tests if the absolute difference of the compared values (actual VS expected) is greater than delta.
+func (a AssertableTime) IsWithinDuration(expected time.Time, delta time.Duration) AssertableTime {
+	if difference := a.actual.AbsDifference(expected); difference > delta {
+		a.error(shouldBeWithinDuration(a.actual, expected, delta, difference))
 	}
 	return a
 }
@@ -44,7 +113,7 @@ func (a AssertableTime) IsAlmostSameAs(expected time.Time) AssertableTime {
 // It errors the tests if the compared values (actual VS expected) are equal.
 func (a AssertableTime) IsNotTheSameAs(expected time.Time) AssertableTime {
 	if a.actual.IsSameAs(expected) {
-		a.t.Error(shouldNotBeEqual(a.actual, expected))
+		a.error(shouldNotBeEqual(a.actual, expected))
 	}
 	return a
 }
@@ -53,7 +122,7 @@ func (a AssertableTime) IsNotTheSameAs(expected time.Time) AssertableTime {
 // It errors the tests if is not greater.
 func (a AssertableTime) IsBefore(expected time.Time) AssertableTime {
 	if !a.actual.IsBefore(expected) {
-		a.t.Error(shouldBeGreater(a.actual, expected))
+		a.error(shouldBeGreater(a.actual, expected))
 	}
 	return a
 }
@@ -62,7 +131,38 @@ func (a AssertableTime) IsBefore(expected time.Time) AssertableTime {
 // It errors the tests if is not later.
 func (a AssertableTime) IsAfter(expected time.Time) AssertableTime {
 	if !a.actual.IsAfter(expected) {
-		a.t.Error(shouldBeGreaterOrEqual(a.actual, expected))
+		a.error(shouldBeGreaterOrEqual(a.actual, expected))
+	}
+	return a
+}
+
+// IsNotBefore asserts if the assertable time.Time value is not before the expected value, so an equal value passes
+// It errors the tests if is strictly before.
+func (a AssertableTime) IsNotBefore(expected time.Time) AssertableTime {
+	if a.actual.IsBefore(expected) {
+		a.error(shouldNotBeBefore(a.actual, expected))
+	}
+	return a
+}
+
+// IsNotAfter asserts if the assertable time.Time value is not after the expected value, so an equal value passes
+// It errors the tests if is strictly after.
+func (a AssertableTime) IsNotAfter(expected time.Time) AssertableTime {
+	if a.actual.IsAfter(expected) {
+		a.error(shouldNotBeAfter(a.actual, expected))
+	}
+	return a
+}
+
+// IsBetween asserts if the assertable time.Time value is between the start and end values, inclusive of both
+// It errors the tests if the value is before start or after end.
+// It panics if start is after end.
+func (a AssertableTime) IsBetween(start, end time.Time) AssertableTime {
+	if start.After(end) {
+		panic(fmt.Sprintf("invalid time range: start %+v is after end %+v", start, end))
+	}
+	if a.actual.IsBefore(start) || a.actual.IsAfter(end) {
+		a.error(shouldBeBetween(a.actual, start, end))
 	}
 	return a
 }
@@ -71,7 +171,7 @@ func (a AssertableTime) IsAfter(expected time.Time) AssertableTime {
 // It errors the tests if the value is not defined.
 func (a AssertableTime) IsDefined() AssertableTime {
 	if a.actual.IsNotDefined() {
-		a.t.Error(shouldBeDefined(a.actual))
+		a.error(shouldBeDefined(a.actual))
 	}
 	return a
 }
@@ -80,7 +180,181 @@ func (a AssertableTime) IsDefined() AssertableTime {
 // It errors the tests if the value is defined.
 func (a AssertableTime) IsNotDefined() AssertableTime {
 	if a.actual.IsDefined() {
-		a.t.Error(shouldNotBeDefined(a.actual))
+		a.error(shouldNotBeDefined(a.actual))
+	}
+	return a
+}
+
+// IsZero asserts if the assertable time.Time value is the zero time instant, as reported by time.Time.IsZero, which
+// is the value of a time.Time that was never set.
+// It errors the tests if the value is not the zero time instant.
+func (a AssertableTime) IsZero() AssertableTime {
+	if !a.actual.IsZero() {
+		a.error(shouldBeZeroTime(a.actual))
+	}
+	return a
+}
+
+// IsNotZero asserts if the assertable time.Time value is not the zero time instant, as reported by time.Time.IsZero.
+// It errors the tests if the value is the zero time instant.
+func (a AssertableTime) IsNotZero() AssertableTime {
+	if a.actual.IsZero() {
+		a.error(shouldNotBeZeroTime(a.actual))
+	}
+	return a
+}
+
+// RoundsTo asserts if the assertable time.Time value rounded to the nearest multiple of the given unit is the same
+// instant as the expected value. Rounding follows time.Time.Round so halfway values round up, unlike truncation which
+// always rounds down.
+// It errors the tests if the rounded value is not the same instant as the expected one.
+func (a AssertableTime) RoundsTo(unit time.Duration, expected time.Time) AssertableTime {
+	rounded := a.actual.Value().(time.Time).Round(unit)
+	if !rounded.Equal(expected) {
+		a.error(shouldRoundTo(a.actual, unit, expected, rounded))
+	}
+	return a
+}
+
+// IsNextOccurrenceOf asserts if the assertable time.Time value falls on the first day of the given weekday strictly
+// after the given time. If from already falls on the given weekday, the occurrence of the following week is expected.
+// Day boundaries are determined in the location set by WithLocation, or else in the location of from.
+// It errors the tests if the value doesn't fall on the expected next occurrence.
+func (a AssertableTime) IsNextOccurrenceOf(weekday time.Weekday, from time.Time) AssertableTime {
+	location := from.Location()
+	if a.location != nil {
+		location = a.location
+	}
+	from = from.In(location)
+	days := (int(weekday)-int(from.Weekday())+6)%7 + 1
+	expected := time.Date(from.Year(), from.Month(), from.Day()+days, 0, 0, 0, 0, location)
+
+	actual := a.actual.Value().(time.Time).In(location)
+	if actual.Year() != expected.Year() || actual.YearDay() != expected.YearDay() {
+		a.error(shouldBeNextOccurrenceOf(a.actual, weekday, from, expected))
+	}
+	return a
+}
+
+// IsInThePast asserts if the assertable time.Time value is before the current time, as returned by the clock set by
+// WithClock when the assertion is called.
+// It errors the tests if the value is not before the current time.
+func (a AssertableTime) IsInThePast() AssertableTime {
+	if now := a.clock(); !a.actual.Value().(time.Time).Before(now) {
+		a.error(shouldBeRelativeToNow(a.actual, "in the past", now))
+	}
+	return a
+}
+
+// IsInTheFuture asserts if the assertable time.Time value is after the current time, as returned by the clock set by
+// WithClock when the assertion is called.
+// It errors the tests if the value is not after the current time.
+func (a AssertableTime) IsInTheFuture() AssertableTime {
+	if now := a.clock(); !a.actual.Value().(time.Time).After(now) {
+		a.error(shouldBeRelativeToNow(a.actual, "in the future", now))
+	}
+	return a
+}
+
+// DifferenceFrom returns an AssertableDuration over the duration elapsed from the given time to the assertable
+// time.Time value, so the gap between the two can be asserted. The duration is negative if the value is before the
+// given time.
+func (a AssertableTime) DifferenceFrom(other time.Time) AssertableDuration {
+	return thatDuration(a.assertion, a.actual.Value().(time.Time).Sub(other))
+}
+
+// UnixSeconds returns an AssertableInt over the assertable time.Time value as the number of seconds elapsed since
+// January 1, 1970 UTC, so serialized epoch timestamps can be asserted.
+func (a AssertableTime) UnixSeconds() AssertableInt {
+	return thatInt(a.assertion, int(a.actual.Value().(time.Time).Unix()))
+}
+
+// UnixMillis returns an AssertableInt over the assertable time.Time value as the number of milliseconds elapsed since
+// January 1, 1970 UTC, so serialized epoch timestamps can be asserted.
+func (a AssertableTime) UnixMillis() AssertableInt {
+	return thatInt(a.assertion, int(a.actual.Value().(time.Time).UnixMilli()))
+}
+
+// IsMidnight asserts if the assertable time.Time value is at midnight, that is its hour, minute, second and nanosecond
+// are all zero. The clock time is read in the location set by WithLocation, or else in the location of the value.
+// It errors the tests if the value is not at midnight.
+func (a AssertableTime) IsMidnight() AssertableTime {
+	actual := a.localTime()
+	if actual.Hour() != 0 || actual.Minute() != 0 || actual.Second() != 0 || actual.Nanosecond() != 0 {
+		a.error(shouldBeAtTimeOfDay(a.actual, "00:00:00", actual))
+	}
+	return a
+}
+
+// HasTimeOfDay asserts if the assertable time.Time value has the given hour, minute and second, ignoring its
+// nanoseconds. The clock time is read in the location set by WithLocation, or else in the location of the value.
+// It errors the tests if the value has a different clock time.
+func (a AssertableTime) HasTimeOfDay(hour, minute, second int) AssertableTime {
+	actual := a.localTime()
+	if actual.Hour() != hour || actual.Minute() != minute || actual.Second() != second {
+		a.error(shouldBeAtTimeOfDay(a.actual, fmt.Sprintf("%02d:%02d:%02d", hour, minute, second), actual))
 	}
 	return a
 }
+
+// HasSameDateAs asserts if the assertable time.Time value falls on the same year, month and day as the expected value,
+// ignoring the time of day. Both values are read in the location set by WithLocation, or else in the location of the
+// assertable value, so the expected value is normalized to that location before the dates are compared.
+// It errors the tests if the values fall on different dates.
+func (a AssertableTime) HasSameDateAs(expected time.Time) AssertableTime {
+	actual, other := a.localTime(), a.inLocation(expected)
+	if actual.Year() != other.Year() || actual.YearDay() != other.YearDay() {
+		a.error(shouldHaveSameCalendarValue(a.actual, "date", expected,
+			actual.Format("2006-01-02"), other.Format("2006-01-02"), actual.Location()))
+	}
+	return a
+}
+
+// HasSameDayOfWeekAs asserts if the assertable time.Time value falls on the same day of the week as the expected value.
+// Both values are read in the location set by WithLocation, or else in the location of the assertable value, so the
+// expected value is normalized to that location before the weekdays are compared.
+// It errors the tests if the values fall on different days of the week.
+func (a AssertableTime) HasSameDayOfWeekAs(expected time.Time) AssertableTime {
+	actual, other := a.localTime(), a.inLocation(expected)
+	if actual.Weekday() != other.Weekday() {
+		a.error(shouldHaveSameCalendarValue(a.actual, "day of week", expected,
+			actual.Weekday().String(), other.Weekday().String(), actual.Location()))
+	}
+	return a
+}
+
+func (a AssertableTime) localTime() time.Time {
+	actual := a.actual.Value().(time.Time)
+	if a.location != nil {
+		return actual.In(a.location)
+	}
+	return actual
+}
+
+func (a AssertableTime) inLocation(value time.Time) time.Time {
+	return value.In(a.localTime().Location())
+}
+
+// And returns the assertable unchanged, so chained assertions can read more fluently, for example
+// IsAfter(start).And().IsBefore(deadline).
+func (a AssertableTime) And() AssertableTime {
+	return a
+}
+
+// Also is an alias of And.
+func (a AssertableTime) Also() AssertableTime {
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableTime) WithMessage(message string) AssertableTime {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableTime) AsRequirement() AssertableTime {
+	a.requirement = true
+	return a
+}
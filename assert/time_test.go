@@ -201,3 +201,795 @@ func TestAssertableTime_IsAlmostSameAs(t *testing.T) {
 		})
 	}
 }
+
+func TestThatTimeString(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		layout     string
+		expected   time.Time
+		shouldFail bool
+	}{
+		{
+			name:     "should parse RFC3339 time",
+			value:    "2000-01-01T10:20:30Z",
+			layout:   time.RFC3339,
+			expected: time.Date(2000, 1, 1, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			name:     "should parse custom layout time",
+			value:    "01/02/2000",
+			layout:   "02/01/2006",
+			expected: time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail if value doesn't match the layout",
+			value:      "2000-01-01",
+			layout:     time.RFC3339,
+			expected:   time.Time{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTimeString(test, tt.value, tt.layout).IsSameAs(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsSameInstantAs(t *testing.T) {
+	athens := time.FixedZone("EET", 2*60*60)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   time.Time
+		shouldFail bool
+	}{
+		{
+			name:     "should assert same instant in different locations",
+			actual:   time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2000, 1, 1, 14, 0, 0, 0, athens),
+		},
+		{
+			name:     "should assert same instant in the same location",
+			actual:   time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail for same wall clock in different locations",
+			actual:     time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected:   time.Date(2000, 1, 1, 12, 0, 0, 0, athens),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsSameInstantAs(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_RoundsTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     time.Time
+		unit       time.Duration
+		expected   time.Time
+		shouldFail bool
+	}{
+		{
+			name:     "should round down to the nearest unit",
+			actual:   time.Date(2000, 1, 1, 10, 7, 0, 0, time.UTC),
+			unit:     15 * time.Minute,
+			expected: time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "should round up to the nearest unit",
+			actual:   time.Date(2000, 1, 1, 10, 8, 0, 0, time.UTC),
+			unit:     15 * time.Minute,
+			expected: time.Date(2000, 1, 1, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:     "should round halfway values up",
+			actual:   time.Date(2000, 1, 1, 10, 7, 30, 0, time.UTC),
+			unit:     15 * time.Minute,
+			expected: time.Date(2000, 1, 1, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail if truncated value is expected",
+			actual:     time.Date(2000, 1, 1, 10, 8, 0, 0, time.UTC),
+			unit:       15 * time.Minute,
+			expected:   time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).RoundsTo(tt.unit, tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsNextOccurrenceOf(t *testing.T) {
+	// 2000-01-03 is a Monday
+	monday := time.Date(2000, 1, 3, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		weekday    time.Weekday
+		from       time.Time
+		timeOpts   []TimeOpt
+		shouldFail bool
+	}{
+		{
+			name:    "should assert next occurrence later in the week",
+			actual:  time.Date(2000, 1, 5, 8, 0, 0, 0, time.UTC),
+			weekday: time.Wednesday,
+			from:    monday,
+		},
+		{
+			name:    "should assert next occurrence in the following week",
+			actual:  time.Date(2000, 1, 9, 0, 0, 0, 0, time.UTC),
+			weekday: time.Sunday,
+			from:    monday,
+		},
+		{
+			name:    "should assert next occurrence of the same weekday strictly after",
+			actual:  time.Date(2000, 1, 10, 0, 0, 0, 0, time.UTC),
+			weekday: time.Monday,
+			from:    monday,
+		},
+		{
+			name:       "should fail for the same day",
+			actual:     time.Date(2000, 1, 3, 23, 0, 0, 0, time.UTC),
+			weekday:    time.Monday,
+			from:       monday,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for the second occurrence",
+			actual:     time.Date(2000, 1, 12, 0, 0, 0, 0, time.UTC),
+			weekday:    time.Wednesday,
+			from:       monday,
+			shouldFail: true,
+		},
+		{
+			name:     "should respect the given location for day boundaries",
+			actual:   time.Date(2000, 1, 4, 22, 0, 0, 0, time.UTC),
+			weekday:  time.Wednesday,
+			from:     monday,
+			timeOpts: []TimeOpt{WithLocation(time.FixedZone("UTC+3", 3*60*60))},
+		},
+		{
+			name:       "should fail if the day differs in the given location",
+			actual:     time.Date(2000, 1, 5, 22, 0, 0, 0, time.UTC),
+			weekday:    time.Wednesday,
+			from:       monday,
+			timeOpts:   []TimeOpt{WithLocation(time.FixedZone("UTC+3", 3*60*60))},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, tt.timeOpts...).IsNextOccurrenceOf(tt.weekday, tt.from)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsInThePast(t *testing.T) {
+	now := time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	tests := []struct {
+		name       string
+		actual     time.Time
+		shouldFail bool
+	}{
+		{
+			name:   "should assert time before now",
+			actual: now.Add(-time.Nanosecond),
+		},
+		{
+			name:       "should fail for now",
+			actual:     now,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for time after now",
+			actual:     now.Add(time.Hour),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, WithClock(clock)).IsInThePast()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsInTheFuture(t *testing.T) {
+	now := time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	tests := []struct {
+		name       string
+		actual     time.Time
+		shouldFail bool
+	}{
+		{
+			name:   "should assert time after now",
+			actual: now.Add(time.Nanosecond),
+		},
+		{
+			name:       "should fail for now",
+			actual:     now,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for time before now",
+			actual:     now.Add(-time.Hour),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, WithClock(clock)).IsInTheFuture()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsInThePast_WithoutClock(t *testing.T) {
+	test := &testing.T{}
+	ThatTime(test, time.Now().Add(-time.Hour)).IsInThePast()
+	ThatTime(test, time.Now().Add(time.Hour)).IsInTheFuture()
+	ThatBool(t, test.Failed()).IsFalse()
+}
+
+func TestThatTime_NilOption(t *testing.T) {
+	test := &testing.T{}
+	actual := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	ThatTime(test, actual, nil, WithLocation(time.UTC)).IsSameAs(actual)
+	ThatBool(t, test.Failed()).IsFalse()
+}
+
+func TestAssertableTime_IsInThePast_WithNilClock(t *testing.T) {
+	test := &testing.T{}
+	ThatTime(test, time.Now().Add(-time.Hour), WithClock(nil)).IsInThePast()
+	ThatTime(test, time.Now().Add(time.Hour), WithClock(nil)).IsInTheFuture()
+	ThatBool(t, test.Failed()).IsFalse()
+}
+
+func TestAssertableTime_DifferenceFrom(t *testing.T) {
+	start := time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		other      time.Time
+		expected   time.Duration
+		shouldFail bool
+	}{
+		{
+			name:     "should return positive difference",
+			actual:   start.Add(90 * time.Minute),
+			other:    start,
+			expected: 90 * time.Minute,
+		},
+		{
+			name:     "should return negative difference",
+			actual:   start,
+			other:    start.Add(time.Second),
+			expected: -time.Second,
+		},
+		{
+			name:       "should fail for different difference",
+			actual:     start.Add(time.Hour),
+			other:      start,
+			expected:   time.Minute,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).DifferenceFrom(tt.other).IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_UnixSeconds(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should return epoch seconds",
+			actual:   time.Date(2001, 9, 9, 1, 46, 40, 999, time.UTC),
+			expected: 1000000000,
+		},
+		{
+			name:     "should return epoch seconds regardless of location",
+			actual:   time.Date(2001, 9, 9, 4, 46, 40, 0, time.FixedZone("UTC+3", 3*60*60)),
+			expected: 1000000000,
+		},
+		{
+			name:       "should fail for different epoch seconds",
+			actual:     time.Date(2001, 9, 9, 1, 46, 41, 0, time.UTC),
+			expected:   1000000000,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).UnixSeconds().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_UnixMillis(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   int
+		shouldFail bool
+	}{
+		{
+			name:     "should return epoch milliseconds",
+			actual:   time.Date(2001, 9, 9, 1, 46, 40, 123456789, time.UTC),
+			expected: 1000000000123,
+		},
+		{
+			name:     "should return zero for epoch",
+			actual:   time.Unix(0, 0),
+			expected: 0,
+		},
+		{
+			name:     "should return epoch milliseconds of the zero time",
+			actual:   time.Time{},
+			expected: -62135596800000,
+		},
+		{
+			name:       "should fail for different epoch milliseconds",
+			actual:     time.Date(2001, 9, 9, 1, 46, 40, 124000000, time.UTC),
+			expected:   1000000000123,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).UnixMillis().IsEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsMidnight(t *testing.T) {
+	utcPlus3 := time.FixedZone("UTC+3", 3*60*60)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		timeOpts   []TimeOpt
+		shouldFail bool
+	}{
+		{
+			name:   "should assert midnight",
+			actual: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail for a nanosecond past midnight",
+			actual:     time.Date(2000, 1, 1, 0, 0, 0, 1, time.UTC),
+			shouldFail: true,
+		},
+		{
+			name:     "should assert midnight in the given location",
+			actual:   time.Date(2000, 1, 1, 21, 0, 0, 0, time.UTC),
+			timeOpts: []TimeOpt{WithLocation(utcPlus3)},
+		},
+		{
+			name:       "should fail for midnight in another location",
+			actual:     time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			timeOpts:   []TimeOpt{WithLocation(utcPlus3)},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, tt.timeOpts...).IsMidnight()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_HasTimeOfDay(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     time.Time
+		hour       int
+		minute     int
+		second     int
+		timeOpts   []TimeOpt
+		shouldFail bool
+	}{
+		{
+			name:   "should assert time of day ignoring nanoseconds",
+			actual: time.Date(2000, 1, 1, 9, 30, 15, 500, time.UTC),
+			hour:   9,
+			minute: 30,
+			second: 15,
+		},
+		{
+			name:     "should assert time of day in the given location",
+			actual:   time.Date(2000, 1, 1, 9, 30, 0, 0, time.UTC),
+			hour:     12,
+			minute:   30,
+			second:   0,
+			timeOpts: []TimeOpt{WithLocation(time.FixedZone("UTC+3", 3*60*60))},
+		},
+		{
+			name:       "should fail for different time of day",
+			actual:     time.Date(2000, 1, 1, 9, 30, 15, 0, time.UTC),
+			hour:       9,
+			minute:     31,
+			second:     15,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, tt.timeOpts...).HasTimeOfDay(tt.hour, tt.minute, tt.second)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsNotBefore(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   time.Time
+		shouldFail bool
+	}{
+		{
+			name:     "should assert later time",
+			actual:   now.Add(time.Hour),
+			expected: now,
+		},
+		{
+			name:     "should assert equal time",
+			actual:   now,
+			expected: now,
+		},
+		{
+			name:       "should fail for earlier time",
+			actual:     now,
+			expected:   now.Add(time.Hour),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsNotBefore(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsNotAfter(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   time.Time
+		shouldFail bool
+	}{
+		{
+			name:     "should assert earlier time",
+			actual:   now,
+			expected: now.Add(time.Hour),
+		},
+		{
+			name:     "should assert equal time",
+			actual:   now,
+			expected: now,
+		},
+		{
+			name:       "should fail for later time",
+			actual:     now.Add(time.Hour),
+			expected:   now,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsNotAfter(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsBetween(t *testing.T) {
+	start := time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		shouldFail bool
+	}{
+		{
+			name:   "should assert time within the range",
+			actual: start.Add(30 * time.Minute),
+		},
+		{
+			name:   "should assert the start bound",
+			actual: start,
+		},
+		{
+			name:   "should assert the end bound",
+			actual: end,
+		},
+		{
+			name:       "should fail for time before the range",
+			actual:     start.Add(-time.Nanosecond),
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for time after the range",
+			actual:     end.Add(time.Nanosecond),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsBetween(start, end)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsBetween_InvalidRange(t *testing.T) {
+	defer func() {
+		ThatBool(t, recover() != nil).IsTrue()
+	}()
+	start := time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC)
+	ThatTime(&testing.T{}, start).IsBetween(start.Add(time.Hour), start)
+}
+
+func TestAssertableTime_IsWithinDuration(t *testing.T) {
+	expected := time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		delta      time.Duration
+		shouldFail bool
+	}{
+		{
+			name:   "should assert time within delta",
+			actual: expected.Add(500 * time.Millisecond),
+			delta:  time.Second,
+		},
+		{
+			name:   "should assert time exactly delta after",
+			actual: expected.Add(time.Second),
+			delta:  time.Second,
+		},
+		{
+			name:   "should assert time exactly delta before",
+			actual: expected.Add(-time.Second),
+			delta:  time.Second,
+		},
+		{
+			name:       "should fail for time just over delta after",
+			actual:     expected.Add(time.Second + time.Nanosecond),
+			delta:      time.Second,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for time just over delta before",
+			actual:     expected.Add(-time.Second - time.Nanosecond),
+			delta:      time.Second,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for zero time far before",
+			actual:     time.Time{},
+			delta:      time.Second,
+			shouldFail: true,
+		},
+		{
+			name:       "should fail for time far after",
+			actual:     time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+			delta:      time.Second,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsWithinDuration(expected, tt.delta)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_And(t *testing.T) {
+	test := &testing.T{}
+	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	assertable := ThatTime(test, start)
+	ThatTime(t, assertable.And().actual.Value().(time.Time)).IsSameAs(start)
+	ThatTime(t, assertable.Also().actual.Value().(time.Time)).IsSameAs(start)
+
+	assertable.IsAfter(start.Add(-time.Hour)).And().IsBefore(start.Add(time.Hour)).Also().IsNotTheSameAs(start.Add(time.Second))
+	ThatBool(t, test.Failed()).IsFalse()
+}
+
+func TestAssertableTime_HasSameDateAs(t *testing.T) {
+	utcPlus3 := time.FixedZone("UTC+3", 3*60*60)
+	utcMinus5 := time.FixedZone("UTC-5", -5*60*60)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   time.Time
+		timeOpts   []TimeOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should assert same date with different time of day",
+			actual:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2000, 1, 1, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:       "should fail for different dates",
+			actual:     time.Date(2000, 1, 1, 23, 59, 59, 0, time.UTC),
+			expected:   time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+			shouldFail: true,
+		},
+		{
+			name:     "should normalize expected value to the actual location across a day boundary",
+			actual:   time.Date(2000, 1, 2, 1, 0, 0, 0, utcPlus3),
+			expected: time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail when the dates differ in the actual location",
+			actual:     time.Date(2000, 1, 1, 20, 0, 0, 0, utcMinus5),
+			expected:   time.Date(2000, 1, 2, 9, 0, 0, 0, utcPlus3),
+			shouldFail: true,
+		},
+		{
+			name:     "should compare in the location set by WithLocation",
+			actual:   time.Date(2000, 1, 2, 1, 0, 0, 0, utcPlus3),
+			expected: time.Date(2000, 1, 1, 23, 0, 0, 0, time.UTC),
+			timeOpts: []TimeOpt{WithLocation(utcMinus5)},
+		},
+		{
+			name:       "should fail when the dates differ in the location set by WithLocation",
+			actual:     time.Date(2000, 1, 1, 22, 0, 0, 0, time.UTC),
+			expected:   time.Date(2000, 1, 1, 20, 0, 0, 0, time.UTC),
+			timeOpts:   []TimeOpt{WithLocation(utcPlus3)},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, tt.timeOpts...).HasSameDateAs(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_HasSameDayOfWeekAs(t *testing.T) {
+	utcPlus3 := time.FixedZone("UTC+3", 3*60*60)
+	tests := []struct {
+		name       string
+		actual     time.Time
+		expected   time.Time
+		timeOpts   []TimeOpt
+		shouldFail bool
+	}{
+		{
+			name:     "should assert same day of week in different weeks",
+			actual:   time.Date(2000, 1, 3, 8, 0, 0, 0, time.UTC),
+			expected: time.Date(2000, 1, 10, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail for different days of week",
+			actual:     time.Date(2000, 1, 3, 8, 0, 0, 0, time.UTC),
+			expected:   time.Date(2000, 1, 4, 8, 0, 0, 0, time.UTC),
+			shouldFail: true,
+		},
+		{
+			name:     "should normalize expected value to the actual location across a day boundary",
+			actual:   time.Date(2000, 1, 4, 1, 0, 0, 0, utcPlus3),
+			expected: time.Date(2000, 1, 3, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail when the days differ in the location set by WithLocation",
+			actual:     time.Date(2000, 1, 3, 22, 0, 0, 0, time.UTC),
+			expected:   time.Date(2000, 1, 3, 20, 0, 0, 0, time.UTC),
+			timeOpts:   []TimeOpt{WithLocation(utcPlus3)},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual, tt.timeOpts...).HasSameDayOfWeekAs(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsZero(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     time.Time
+		shouldFail bool
+	}{
+		{
+			name:   "should assert the zero value",
+			actual: time.Time{},
+		},
+		{
+			name:   "should assert the zero instant in another location",
+			actual: time.Time{}.In(time.FixedZone("UTC+3", 3*60*60)),
+		},
+		{
+			name:       "should fail for a real timestamp",
+			actual:     time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsZero()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableTime_IsNotZero(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     time.Time
+		shouldFail bool
+	}{
+		{
+			name:   "should assert a real timestamp",
+			actual: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "should fail for the zero value",
+			actual:     time.Time{},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatTime(test, tt.actual).IsNotZero()
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
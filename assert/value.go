@@ -0,0 +1,71 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/ppapapetrou76/go-testing/internal/pkg/values"
+)
+
+// AssertableValue is the type safe assertable structure for values of any comparable type.
+// Unlike AssertableAny the expected values must have the type of the asserted value, so that comparing values of
+// different types, such as an int with an int64, is a compile error instead of a failed assertion.
+type AssertableValue[T comparable] struct {
+	assertion
+	actual T
+}
+
+// ThatValue returns an AssertableValue structure initialized with the test reference and the actual value to assert.
+func ThatValue[T comparable](t *testing.T, actual T) AssertableValue[T] {
+	t.Helper()
+	return thatValue(assertion{t: t}, actual)
+}
+
+func thatValue[T comparable](a assertion, actual T) AssertableValue[T] {
+	return AssertableValue[T]{
+		assertion: a,
+		actual:    actual,
+	}
+}
+
+// IsEqualTo asserts if the expected value is equal to the assertable value, using the == operator
+// It errors the tests if the compared values (actual VS expected) are not equal.
+func (a AssertableValue[T]) IsEqualTo(expected T) AssertableValue[T] {
+	if a.actual != expected {
+		a.error(shouldBeEqual(values.NewAnyValue(a.actual), expected))
+	}
+	return a
+}
+
+// IsNotEqualTo asserts if the expected value is not equal to the assertable value, using the == operator
+// It errors the tests if the compared values (actual VS expected) are equal.
+func (a AssertableValue[T]) IsNotEqualTo(expected T) AssertableValue[T] {
+	if a.actual == expected {
+		a.error(shouldNotBeEqual(values.NewAnyValue(a.actual), expected))
+	}
+	return a
+}
+
+// IsOneOf asserts if the assertable value is equal to any of the given candidates, using the == operator
+// It errors the tests if the value is equal to none of the candidates, so it always errors without candidates.
+func (a AssertableValue[T]) IsOneOf(candidates ...T) AssertableValue[T] {
+	for _, candidate := range candidates {
+		if a.actual == candidate {
+			return a
+		}
+	}
+	a.error(shouldBeOneOf(values.NewAnyValue(a.actual), candidates))
+	return a
+}
+
+// WithMessage sets a custom message to be added before the error messages of the following assertions.
+func (a AssertableValue[T]) WithMessage(message string) AssertableValue[T] {
+	a.customMessage = message
+	return a
+}
+
+// AsRequirement makes the following assertions requirements: instead of erroring the test and letting it continue,
+// a failed requirement stops the test immediately using t.Fatal.
+func (a AssertableValue[T]) AsRequirement() AssertableValue[T] {
+	a.requirement = true
+	return a
+}
@@ -0,0 +1,143 @@
+package assert
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+type point struct {
+	x, y int
+}
+
+func TestAssertableValue_IsEqualTo(t *testing.T) {
+	t.Run("should assert equal ints", func(t *testing.T) {
+		test := &testing.T{}
+		ThatValue(test, 1).IsEqualTo(1)
+		ThatBool(t, test.Failed()).IsFalse()
+	})
+	t.Run("should assert equal typed constants", func(t *testing.T) {
+		test := &testing.T{}
+		ThatValue(test, int64(1)).IsEqualTo(1)
+		ThatBool(t, test.Failed()).IsFalse()
+	})
+	t.Run("should assert equal structs", func(t *testing.T) {
+		test := &testing.T{}
+		ThatValue(test, point{x: 1, y: 2}).IsEqualTo(point{x: 1, y: 2})
+		ThatBool(t, test.Failed()).IsFalse()
+	})
+	t.Run("should fail for different strings", func(t *testing.T) {
+		test := &testing.T{}
+		ThatValue(test, "a").IsEqualTo("b")
+		ThatBool(t, test.Failed()).IsTrue()
+	})
+	t.Run("should fail for different structs", func(t *testing.T) {
+		test := &testing.T{}
+		ThatValue(test, point{x: 1, y: 2}).IsEqualTo(point{x: 2, y: 1})
+		ThatBool(t, test.Failed()).IsTrue()
+	})
+}
+
+func TestAssertableValue_IsNotEqualTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     string
+		expected   string
+		shouldFail bool
+	}{
+		{
+			name:     "should assert different values",
+			actual:   "a",
+			expected: "b",
+		},
+		{
+			name:       "should fail for equal values",
+			actual:     "a",
+			expected:   "a",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatValue(test, tt.actual).IsNotEqualTo(tt.expected)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableValue_IsOneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		actual     int
+		candidates []int
+		shouldFail bool
+	}{
+		{
+			name:       "should assert value in the middle of the candidates",
+			actual:     2,
+			candidates: []int{1, 2, 3},
+		},
+		{
+			name:       "should fail for value not in the candidates",
+			actual:     4,
+			candidates: []int{1, 2, 3},
+			shouldFail: true,
+		},
+		{
+			name:       "should fail without candidates",
+			actual:     1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testing.T{}
+			ThatValue(test, tt.actual).IsOneOf(tt.candidates...)
+			ThatBool(t, test.Failed()).IsEqualTo(tt.shouldFail)
+		})
+	}
+}
+
+func TestAssertableValue_TypeMismatchDoesNotCompile(t *testing.T) {
+	tests := []struct {
+		name       string
+		statement  string
+		shouldFail bool
+	}{
+		{
+			name:      "should compile for values of the same type",
+			statement: "assert.ThatValue(t, 1).IsEqualTo(2)",
+		},
+		{
+			name:       "should not compile for IsEqualTo with a value of other type",
+			statement:  "assert.ThatValue(t, 1).IsEqualTo(int64(1))",
+			shouldFail: true,
+		},
+		{
+			name:       "should not compile for IsOneOf with a value of other type",
+			statement:  `assert.ThatValue(t, "a").IsOneOf("b", 1.5)`,
+			shouldFail: true,
+		},
+	}
+	fileSet := token.NewFileSet()
+	imports := importer.ForCompiler(fileSet, "source", nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := "package snippet\n\n" +
+				"import (\n\t\"testing\"\n\n\t\"github.com/ppapapetrou76/go-testing/assert\"\n)\n\n" +
+				"func snippet(t *testing.T) {\n\t" + tt.statement + "\n}\n"
+			file, err := parser.ParseFile(fileSet, "snippet.go", source, 0)
+			ThatError(t, err).IsNil()
+			config := types.Config{Importer: imports}
+			_, err = config.Check("snippet", fileSet, []*ast.File{file}, nil)
+			ThatBool(t, err != nil).IsEqualTo(tt.shouldFail)
+			if tt.shouldFail {
+				ThatString(t, err.Error()).Contains("cannot use")
+			}
+		})
+	}
+}
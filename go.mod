@@ -1,5 +1,7 @@
 module github.com/ppapapetrou76/go-testing
 
-go 1.15
+go 1.18
 
 require github.com/r3labs/diff/v2 v2.13.0
+
+require github.com/vmihailenco/msgpack v4.0.4+incompatible // indirect
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -15,11 +16,30 @@ func (t TimeValue) IsSameAs(expected interface{}) bool {
 	return t.value == expected
 }
 
+// IsSameInstantAs returns true if the value represents the same instant as the expected value regardless of their
+// locations, else false.
+func (t TimeValue) IsSameInstantAs(expected interface{}) bool {
+	return t.value.Equal(NewTimeValue(expected).value)
+}
+
 // IsAlmostSameAs returns true if the value is the almost the same as the expected value, else false.
 func (t TimeValue) IsAlmostSameAs(expected interface{}) bool {
 	return NewTimeValue(t.value.Add(time.Millisecond*100)).IsAfter(expected) && NewTimeValue(t.value.Add(-time.Millisecond*100)).IsBefore(expected)
 }
 
+// AbsDifference returns the absolute duration between the value and the expected value. A difference too large to be
+// represented saturates to the maximum duration.
+func (t TimeValue) AbsDifference(expected interface{}) time.Duration {
+	difference := t.value.Sub(NewTimeValue(expected).value)
+	if difference == math.MinInt64 {
+		return math.MaxInt64
+	}
+	if difference < 0 {
+		return -difference
+	}
+	return difference
+}
+
 // IsNotDefined returns true if the time value is not defined (has no value) else false.
 func (t TimeValue) IsNotDefined() bool {
 	return t.value.Nanosecond() == 0
@@ -30,6 +50,11 @@ func (t TimeValue) IsDefined() bool {
 	return !t.IsNotDefined()
 }
 
+// IsZero returns true if the value is the zero time instant, January 1, year 1, 00:00:00 UTC, else false.
+func (t TimeValue) IsZero() bool {
+	return t.value.IsZero()
+}
+
 // IsNotSameAs returns true if the value is not the same as the expected value, else false.
 func (t TimeValue) IsNotSameAs(expected interface{}) bool {
 	return t.value != expected
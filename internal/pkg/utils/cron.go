@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	secondsCronField   = cronField{name: "second", min: 0, max: 59}
+	standardCronFields = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+		// 7 is accepted as an alias of Sunday
+		{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	}
+)
+
+// ValidateCronExpression returns an error naming the field that failed to parse if the given expression is not a valid
+// cron expression, else nil. The expression must have the five standard fields or, if withSeconds is true, six fields
+// starting with the seconds. Every field is a comma separated list of wildcards, values or ranges each one of them
+// optionally followed by a step, for example "*/15", "1-5" or "MON,WED-FRI".
+func ValidateCronExpression(expression string, withSeconds bool) error {
+	fields := standardCronFields
+	if withSeconds {
+		fields = append([]cronField{secondsCronField}, standardCronFields...)
+	}
+
+	values := strings.Fields(expression)
+	if len(values) != len(fields) {
+		return fmt.Errorf("expected %d fields but got %d", len(fields), len(values))
+	}
+	for i, field := range fields {
+		if err := field.validate(values[i]); err != nil {
+			return fmt.Errorf("invalid %s field [%s]: %w", field.name, values[i], err)
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if err := f.validateItem(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) validateItem(item string) error {
+	rangePart := item
+	if i := strings.Index(item, "/"); i != -1 {
+		rangePart = item[:i]
+		step, err := strconv.Atoi(item[i+1:])
+		if err != nil || step < 1 {
+			return fmt.Errorf("step [%s] should be a positive number", item[i+1:])
+		}
+	}
+	if rangePart == "*" {
+		return nil
+	}
+
+	bounds := strings.Split(rangePart, "-")
+	if len(bounds) > 2 {
+		return fmt.Errorf("range [%s] should have a start and an end", rangePart)
+	}
+	start, err := f.parseValue(bounds[0])
+	if err != nil {
+		return err
+	}
+	if len(bounds) == 2 {
+		end, err := f.parseValue(bounds[1])
+		if err != nil {
+			return err
+		}
+		if start > end {
+			return fmt.Errorf("range start %d should not be after its end %d", start, end)
+		}
+	}
+	return nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("value [%s] should be a number", value)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d should be between %d and %d", v, f.min, f.max)
+	}
+	return v, nil
+}
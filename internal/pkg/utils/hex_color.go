@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseHexColor parses the given #RGB, #RRGGBB or #RRGGBBAA hex color case insensitively and returns its red, green,
+// blue and, if present, alpha components. Short #RGB colors are expanded so #fa0 has the components of #ffaa00.
+// The leading # may be omitted only if hashOptional is true.
+func ParseHexColor(value string, hashOptional bool) ([]int, error) {
+	digits := strings.TrimPrefix(value, "#")
+	if digits == value && !hashOptional {
+		return nil, errors.New("it should start with #")
+	}
+
+	switch len(digits) {
+	case 3:
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	case 6, 8:
+	default:
+		return nil, fmt.Errorf("it should have 3, 6 or 8 hex digits but it has %d", len(digits))
+	}
+
+	components := make([]int, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		component, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a hex number", digits[i:i+2])
+		}
+		components = append(components, int(component))
+	}
+	return components, nil
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"encoding/json"
+)
+
+// ParseJSON parses the given JSON document into the generic Go values encoding/json unmarshals to, such as
+// map[string]interface{}, []interface{} and float64, so that documents differing only in key order and whitespace
+// are deeply equal. It also returns the normalized form of the document, indented with sorted keys.
+// It returns an error if the document is not valid JSON.
+func ParseJSON(value string) (parsed interface{}, normalized string, err error) {
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, "", err
+	}
+	marshaled, err := json.MarshalIndent(parsed, "", "  ")
+	if err != nil {
+		return nil, "", err
+	}
+	return parsed, string(marshaled), nil
+}
@@ -0,0 +1,23 @@
+package utils
+
+// PassesLuhn returns true if the digits of the given value, ignoring any other characters, pass the Luhn checksum used
+// by credit card numbers, else false. A value without digits doesn't pass.
+func PassesLuhn(value string) bool {
+	sum, digits := 0, 0
+	runes := []rune(value)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] < '0' || runes[i] > '9' {
+			continue
+		}
+		digit := int(runes[i] - '0')
+		if digits%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		digits++
+	}
+	return digits > 0 && sum%10 == 0
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ParseMACAddress parses the given 6-byte IEEE 802 MAC address, which must be colon or hyphen separated, for example
+// 00:00:5e:00:53:01 or 00-00-5e-00-53-01. Unlike net.ParseMAC, it rejects the dotted 0000.5e00.5301 form and the 8-byte
+// EUI-64 and 20-byte IP over InfiniBand addresses.
+func ParseMACAddress(value string) (net.HardwareAddr, error) {
+	if strings.Contains(value, ".") {
+		return nil, errors.New("it should be colon or hyphen separated")
+	}
+	address, err := net.ParseMAC(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(address) != 6 {
+		return nil, fmt.Errorf("it should have 6 bytes but it has %d", len(address))
+	}
+	return address, nil
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// CanonicalXML stream-parses the given XML document and returns its canonical form, so that documents differing
+// only in whitespace between elements, attribute order, comments or processing instructions have the same canonical
+// form. It returns an error reporting the parse position if the document is not valid XML.
+func CanonicalXML(value string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(value))
+	canonical := strings.Builder{}
+	depth, elements := 0, 0
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("at offset %d: %w", offset, err)
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if depth == 0 && elements > 0 {
+				return "", fmt.Errorf("at offset %d: unexpected element <%s> after the root element", offset, xmlName(token.Name))
+			}
+			depth++
+			elements++
+			canonical.WriteString("<" + xmlName(token.Name))
+			attributes := make([]string, 0, len(token.Attr))
+			for _, attribute := range token.Attr {
+				attributes = append(attributes, fmt.Sprintf(" %s=%q", xmlName(attribute.Name), attribute.Value))
+			}
+			sort.Strings(attributes)
+			canonical.WriteString(strings.Join(attributes, "") + ">")
+		case xml.EndElement:
+			depth--
+			canonical.WriteString("</" + xmlName(token.Name) + ">")
+		case xml.CharData:
+			if text := strings.TrimSpace(string(token)); text != "" {
+				if depth == 0 {
+					return "", fmt.Errorf("at offset %d: unexpected text %q outside of the root element", offset, text)
+				}
+				canonical.WriteString(text)
+			}
+		}
+	}
+
+	if elements == 0 {
+		return "", errors.New("no root element found")
+	}
+	return canonical.String(), nil
+}
+
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
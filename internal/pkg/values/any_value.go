@@ -89,6 +89,15 @@ func (s AnyValue) HasTypeOf(t reflect.Type) bool {
 	return reflect.TypeOf(s.value) == t
 }
 
+// IsComparable returns true if the type of the value supports the == and != operators, else false
+// A nil value is comparable.
+func (s AnyValue) IsComparable() bool {
+	if s.value == nil {
+		return true
+	}
+	return reflect.TypeOf(s.value).Comparable()
+}
+
 // NewAnyValue creates and returns an AnyValue struct initialed with the given value.
 func NewAnyValue(value interface{}) AnyValue {
 	switch v := value.(type) {
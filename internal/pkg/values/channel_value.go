@@ -0,0 +1,64 @@
+package values
+
+import (
+	"reflect"
+	"time"
+)
+
+// ChannelValue is a struct that holds a channel value.
+type ChannelValue struct {
+	value interface{}
+}
+
+// IsReceivable returns true if the value is a channel values can be received from, else false.
+func (c ChannelValue) IsReceivable() bool {
+	channel := reflect.ValueOf(c.value)
+	return channel.Kind() == reflect.Chan && channel.Type().ChanDir()&reflect.RecvDir != 0 && !channel.IsNil()
+}
+
+// ReceiveWithin waits at most the given duration to receive a value from the channel. It returns the received value
+// and true if a value arrived in time, or false for the second result if the channel was closed and for the third
+// result if the timeout elapsed.
+// The channel must be receivable.
+func (c ChannelValue) ReceiveWithin(timeout time.Duration) (value interface{}, received, timedOut bool) {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.value)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(time.After(timeout))},
+	}
+	chosen, recv, ok := reflect.Select(cases)
+	if chosen == 1 {
+		return nil, false, true
+	}
+	if !ok {
+		return nil, false, false
+	}
+	return recv.Interface(), true, false
+}
+
+// TryReceive receives a value from the channel without blocking. It returns the received value and true if a value
+// was ready, or false for the second result if the channel was closed and for the third result if no value was ready.
+// The channel must be receivable.
+func (c ChannelValue) TryReceive() (value interface{}, received, empty bool) {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.value)},
+		{Dir: reflect.SelectDefault},
+	}
+	chosen, recv, ok := reflect.Select(cases)
+	if chosen == 1 {
+		return nil, false, true
+	}
+	if !ok {
+		return nil, false, false
+	}
+	return recv.Interface(), true, false
+}
+
+// Value returns the actual value of the structure.
+func (c ChannelValue) Value() interface{} {
+	return c.value
+}
+
+// NewChannelValue creates and returns a ChannelValue struct initialed with the given value.
+func NewChannelValue(value interface{}) ChannelValue {
+	return ChannelValue{value: value}
+}
@@ -13,8 +13,7 @@ func areEqualValues(actualValue, expectedValue reflect.Value) bool {
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		return NewUIntValue(actualValue.Uint()).IsEqualTo(expectedValue.Uint())
 	case reflect.Float32, reflect.Float64:
-		// This might panic - we need to implement a NewFloatValue
-		return actualValue.Float() == expectedValue.Float()
+		return NewFloatValue(actualValue.Float()).IsEqualTo(expectedValue.Float())
 	case reflect.Array, reflect.Slice:
 		return areSlicesEqual(actualValue, expectedValue)
 	case reflect.Map:
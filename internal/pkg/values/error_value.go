@@ -1,6 +1,10 @@
 package values
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 // ErrorValue is a struct that holds an error value.
 type ErrorValue struct {
@@ -11,6 +15,56 @@ func (v ErrorValue) Error() error {
 	return v.value
 }
 
+// JoinedErrors returns the errors joined in the error value through the Unwrap() []error method that errors.Join and
+// other error aggregations implement. It returns nil if the error value doesn't join any errors.
+func (v ErrorValue) JoinedErrors() []error {
+	if joined, ok := v.value.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return nil
+}
+
+// StackTrace returns the frames of the stack trace carried by the error value or the first error in its chain that
+// exposes a StackTrace() method returning a slice of frames, as the errors of github.com/pkg/errors do. Each frame is
+// formatted using the %+v verb. It returns false if no error in the chain carries a stack trace.
+func (v ErrorValue) StackTrace() ([]string, bool) {
+	for err := v.value; err != nil; err = errors.Unwrap(err) {
+		method := reflect.ValueOf(err).MethodByName("StackTrace")
+		if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 ||
+			method.Type().Out(0).Kind() != reflect.Slice {
+			continue
+		}
+		trace := method.Call(nil)[0]
+		frames := make([]string, 0, trace.Len())
+		for i := 0; i < trace.Len(); i++ {
+			frames = append(frames, fmt.Sprintf("%+v", trace.Index(i).Interface()))
+		}
+		return frames, true
+	}
+	return nil, false
+}
+
+// IsTimeout returns whether the error value, or the first error in its chain that exposes a Timeout() bool method as
+// net.Error does, reports a timeout. The second result is false if no error in the chain exposes the method.
+func (v ErrorValue) IsTimeout() (timeout, classifiable bool) {
+	var err interface{ Timeout() bool }
+	if !errors.As(v.value, &err) {
+		return false, false
+	}
+	return err.Timeout(), true
+}
+
+// IsTemporary returns whether the error value, or the first error in its chain that exposes a Temporary() bool method
+// as net.Error does, reports a temporary failure. The second result is false if no error in the chain exposes the
+// method.
+func (v ErrorValue) IsTemporary() (temporary, classifiable bool) {
+	var err interface{ Temporary() bool }
+	if !errors.As(v.value, &err) {
+		return false, false
+	}
+	return err.Temporary(), true
+}
+
 // Value returns the error value as an interface object.
 func (v ErrorValue) Value() interface{} {
 	return v.value
@@ -0,0 +1,70 @@
+package values
+
+import (
+	"fmt"
+	"math"
+)
+
+// FloatValue is a struct that holds a float value.
+type FloatValue struct {
+	value float64
+}
+
+// IsEqualTo returns true if the value is equal to the expected value, else false.
+func (f FloatValue) IsEqualTo(expected interface{}) bool {
+	return f.equals(NewFloatValue(expected))
+}
+
+// IsEqualToWithin returns true if the value differs from the expected value by at most epsilon, else false.
+func (f FloatValue) IsEqualToWithin(expected interface{}, epsilon float64) bool {
+	return f.equals(NewFloatValue(expected)) || math.Abs(f.value-NewFloatValue(expected).value) <= epsilon
+}
+
+// IsGreaterThan returns true if the value is greater than the expected value, else false.
+func (f FloatValue) IsGreaterThan(expected interface{}) bool {
+	return f.greaterThan(NewFloatValue(expected))
+}
+
+// IsGreaterOrEqualTo returns true if the value is greater than or equal to the expected value, else false.
+func (f FloatValue) IsGreaterOrEqualTo(expected interface{}) bool {
+	return f.greaterOrEqual(NewFloatValue(expected))
+}
+
+// IsLessThan returns true if the value is less than the expected value, else false.
+func (f FloatValue) IsLessThan(expected interface{}) bool {
+	return f.value < NewFloatValue(expected).value
+}
+
+// IsLessOrEqualTo returns true if the value is less than or equal to the expected value, else false.
+func (f FloatValue) IsLessOrEqualTo(expected interface{}) bool {
+	return f.value <= NewFloatValue(expected).value
+}
+
+// Value returns the actual value of the structure.
+func (f FloatValue) Value() interface{} {
+	return f.value
+}
+
+func (f FloatValue) greaterThan(expected FloatValue) bool {
+	return f.value > expected.value
+}
+
+func (f FloatValue) greaterOrEqual(expected FloatValue) bool {
+	return f.value >= expected.value
+}
+
+func (f FloatValue) equals(expected FloatValue) bool {
+	return f.value == expected.value
+}
+
+// NewFloatValue creates and returns a FloatValue struct initialed with the given value.
+func NewFloatValue(value interface{}) FloatValue {
+	switch v := value.(type) {
+	case float32:
+		return FloatValue{value: float64(v)}
+	case float64:
+		return FloatValue{value: v}
+	default:
+		panic(fmt.Sprintf("expected float value type but got %T type", v))
+	}
+}
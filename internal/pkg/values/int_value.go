@@ -2,6 +2,7 @@ package values
 
 import (
 	"fmt"
+	"strings"
 )
 
 // IntValue is a struct that holds an int value.
@@ -34,6 +35,68 @@ func (i IntValue) IsLessOrEqualTo(expected interface{}) bool {
 	return !i.IsGreaterThan(expected)
 }
 
+// DigitCount returns the number of decimal digits of the value ignoring its sign
+// Zero has one digit.
+func (i IntValue) DigitCount() int {
+	count := 1
+	for v := i.value / 10; v != 0; v /= 10 {
+		count++
+	}
+	return count
+}
+
+// IsFactorOf returns true if the value divides the given number without remainder, else false
+// Zero is not a factor of any number.
+func (i IntValue) IsFactorOf(n int) bool {
+	return i.value != 0 && n%i.value == 0
+}
+
+// GCD returns the greatest common divisor of the value and the given number
+// The result is always non-negative and it is zero only if both numbers are zero.
+func (i IntValue) GCD(n int) int {
+	a, b := i.value, n
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// RomanNumeral returns the value in roman numerals. It should be called only for values between 1 and 3999.
+func (i IntValue) RomanNumeral() string {
+	numerals := []struct {
+		value  int
+		symbol string
+	}{
+		{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+		{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+	}
+	roman := strings.Builder{}
+	remainder := i.value
+	for _, numeral := range numerals {
+		for remainder >= numeral.value {
+			roman.WriteString(numeral.symbol)
+			remainder -= numeral.value
+		}
+	}
+	return roman.String()
+}
+
+// Clamped returns the value limited to the [min, max] range: min if the value is less than min, max if the value is
+// greater than max or else the value itself.
+func (i IntValue) Clamped(min, max int) int {
+	switch {
+	case i.value < min:
+		return min
+	case i.value > max:
+		return max
+	default:
+		return i.value
+	}
+}
+
 // Value returns the actual value of the structure.
 func (i IntValue) Value() interface{} {
 	return i.value
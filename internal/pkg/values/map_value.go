@@ -31,6 +31,11 @@ func (s MapValue) IsEqualTo(expected interface{}) bool {
 	return areMapsEqual(actualValue, expectedValue)
 }
 
+// IsNil returns true if the value is a nil map else false.
+func (s MapValue) IsNil() bool {
+	return IsMap(s.Value()) && reflect.ValueOf(s.Value()).IsNil()
+}
+
 // IsEmpty returns true if the map is empty else false.
 func (s MapValue) IsEmpty() bool {
 	return s.HasSize(0)
@@ -88,6 +93,56 @@ func (s MapValue) HasValue(value interface{}) bool {
 	return false
 }
 
+// CountEntries returns the number of the map entries that satisfy the given predicate.
+func (s MapValue) CountEntries(predicate func(key, value interface{}) bool) int {
+	count := 0
+	iter := reflect.ValueOf(s.Value()).MapRange()
+	for iter.Next() {
+		if predicate(iter.Key().Interface(), iter.Value().Interface()) {
+			count++
+		}
+	}
+	return count
+}
+
+// Merged returns a new map holding the entries of the map overlaid by the entries of the given map of the same type
+// Entries of the given map win over entries with the same key and neither of the maps is mutated.
+func (s MapValue) Merged(overlay interface{}) interface{} {
+	baseValue := reflect.ValueOf(s.Value())
+	overlayValue := reflect.ValueOf(overlay)
+	merged := reflect.MakeMapWithSize(baseValue.Type(), baseValue.Len()+overlayValue.Len())
+
+	for _, m := range []reflect.Value{baseValue, overlayValue} {
+		iter := m.MapRange()
+		for iter.Next() {
+			merged.SetMapIndex(iter.Key(), iter.Value())
+		}
+	}
+	return merged.Interface()
+}
+
+// WithoutZeroValues returns a copy of the map without the entries mapped to a nil or zero value. Values held in
+// interfaces are zero if the held value is zero.
+func (s MapValue) WithoutZeroValues() interface{} {
+	mapValue := reflect.ValueOf(s.Value())
+	result := reflect.MakeMap(mapValue.Type())
+
+	iter := mapValue.MapRange()
+	for iter.Next() {
+		if !isZeroValue(iter.Value()) {
+			result.SetMapIndex(iter.Key(), iter.Value())
+		}
+	}
+	return result.Interface()
+}
+
+func isZeroValue(value reflect.Value) bool {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		return isZeroValue(value.Elem())
+	}
+	return value.IsZero()
+}
+
 // NewKeyStringMap creates and returns a MapValue struct initialed with the given value.
 func NewKeyStringMap(value interface{}) MapValue {
 	return MapValue{value: value}
@@ -4,6 +4,12 @@ import (
 	"reflect"
 )
 
+// Run is a run of consecutive equal elements of a slice: the repeated element and the number of its repetitions.
+type Run struct {
+	Value interface{}
+	Count int
+}
+
 // SliceValue is a struct that holds a string slice value.
 type SliceValue struct {
 	value interface{}
@@ -83,6 +89,293 @@ func (s SliceValue) ContainsOnly(elements interface{}) bool {
 	return s.Contains(elements) && s.HasSize(reflect.ValueOf(elements).Len())
 }
 
+// Chunks partitions the slice into consecutive sub-slices of the given size and returns them as a slice of slices
+// The last chunk holds the remaining elements if the slice size is not a multiple of the given size.
+func (s SliceValue) Chunks(size int) interface{} {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+	chunks := reflect.MakeSlice(reflect.SliceOf(actualValue.Type()), 0, 0)
+
+	for i := 0; i < actualValue.Len(); i += size {
+		end := i + size
+		if end > actualValue.Len() {
+			end = actualValue.Len()
+		}
+		chunks = reflect.Append(chunks, actualValue.Slice(i, end))
+	}
+	return chunks.Interface()
+}
+
+// FirstWindowNotSatisfying slides a window of the given size over the slice and returns the starting index of the
+// first window that doesn't satisfy the given predicate or -1 if all of them do.
+// Each window is passed to the predicate as a sub-slice of the same type as the slice.
+func (s SliceValue) FirstWindowNotSatisfying(size int, predicate func(window interface{}) bool) int {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+
+	for i := 0; i+size <= actualValue.Len(); i++ {
+		if !predicate(actualValue.Slice(i, i+size).Interface()) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FirstElementNotSatisfying returns the index of the first element of the slice that doesn't satisfy the given
+// predicate or -1 if all of them do. The predicate is given the index and the value of each element.
+func (s SliceValue) FirstElementNotSatisfying(predicate func(i int, element interface{}) bool) int {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+
+	for i := 0; i < actualValue.Len(); i++ {
+		if !predicate(i, actualValue.Index(i).Interface()) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Partition splits the slice into the elements that satisfy the given predicate and the ones that don't, preserving
+// their order. Both partitions are slices of the same type as the slice.
+func (s SliceValue) Partition(predicate func(element interface{}) bool) (matching, nonMatching interface{}) {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+	matchingValue := reflect.MakeSlice(actualValue.Type(), 0, 0)
+	nonMatchingValue := reflect.MakeSlice(actualValue.Type(), 0, 0)
+
+	for i := 0; i < actualValue.Len(); i++ {
+		if predicate(actualValue.Index(i).Interface()) {
+			matchingValue = reflect.Append(matchingValue, actualValue.Index(i))
+		} else {
+			nonMatchingValue = reflect.Append(nonMatchingValue, actualValue.Index(i))
+		}
+	}
+	return matchingValue.Interface(), nonMatchingValue.Interface()
+}
+
+// Sum returns the sum of the numbers extracted from every element of the slice by the given function
+// It returns zero for an empty slice.
+func (s SliceValue) Sum(extract func(element interface{}) float64) float64 {
+	actualValue := reflect.ValueOf(s.Value())
+	sum := 0.0
+
+	for i := 0; i < actualValue.Len(); i++ {
+		sum += extract(actualValue.Index(i).Interface())
+	}
+	return sum
+}
+
+// Intersection returns the distinct elements of the slice that the other slice also contains, in the order they appear
+// in the slice. The intersection is a slice of the same type as the slice.
+func (s SliceValue) Intersection(other interface{}) interface{} {
+	return s.filterDistinct(func(element reflect.Value) bool {
+		return NewSliceValue(other).contains(element)
+	})
+}
+
+// Difference returns the distinct elements of the slice that the other slice doesn't contain, in the order they appear
+// in the slice. The difference is a slice of the same type as the slice.
+func (s SliceValue) Difference(other interface{}) interface{} {
+	return s.filterDistinct(func(element reflect.Value) bool {
+		return !NewSliceValue(other).contains(element)
+	})
+}
+
+func (s SliceValue) filterDistinct(keep func(element reflect.Value) bool) interface{} {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+	filtered := NewSliceValue(reflect.MakeSlice(actualValue.Type(), 0, 0).Interface())
+
+	for i := 0; i < actualValue.Len(); i++ {
+		element := actualValue.Index(i)
+		if keep(element) && !filtered.contains(element) {
+			filtered = NewSliceValue(reflect.Append(reflect.ValueOf(filtered.Value()), element).Interface())
+		}
+	}
+	return filtered.Value()
+}
+
+// MaxBy returns the first greatest element of the non-empty slice according to the given less function.
+func (s SliceValue) MaxBy(less func(a, b interface{}) bool) interface{} {
+	return s.extremeBy(func(candidate, current interface{}) bool {
+		return less(current, candidate)
+	})
+}
+
+// MinBy returns the first least element of the non-empty slice according to the given less function.
+func (s SliceValue) MinBy(less func(a, b interface{}) bool) interface{} {
+	return s.extremeBy(less)
+}
+
+func (s SliceValue) extremeBy(replaces func(candidate, current interface{}) bool) interface{} {
+	actualValue := reflect.ValueOf(s.Value())
+	extreme := actualValue.Index(0).Interface()
+
+	for i := 1; i < actualValue.Len(); i++ {
+		if candidate := actualValue.Index(i).Interface(); replaces(candidate, extreme) {
+			extreme = candidate
+		}
+	}
+	return extreme
+}
+
+// IndexBy returns a map of the slice elements keyed by the key the given function extracts from each element. The map
+// keys have the type of the extracted keys if all of them have the same non-nil type, else interface{}.
+// If a key is not comparable, as reported by IsComparableKey, or two elements have the same key, it returns that key
+// and false.
+func (s SliceValue) IndexBy(keyFn func(element interface{}) interface{}) (index, invalidKey interface{}, valid bool) {
+	actualValue := reflect.ValueOf(s.Value())
+	keys := make([]interface{}, actualValue.Len())
+	interfaceType := reflect.TypeOf((*interface{})(nil)).Elem()
+	keyType := interfaceType
+
+	for i := range keys {
+		keys[i] = keyFn(actualValue.Index(i).Interface())
+		if !IsComparableKey(keys[i]) {
+			return nil, keys[i], false
+		}
+		switch {
+		case keys[i] == nil:
+			keyType = interfaceType
+		case i == 0:
+			keyType = reflect.TypeOf(keys[i])
+		case keyType != reflect.TypeOf(keys[i]):
+			keyType = interfaceType
+		}
+	}
+
+	indexValue := reflect.MakeMapWithSize(reflect.MapOf(keyType, actualValue.Type().Elem()), len(keys))
+	for i, key := range keys {
+		keyValue := reflect.New(keyType).Elem()
+		if key != nil {
+			keyValue.Set(reflect.ValueOf(key))
+		}
+		if indexValue.MapIndex(keyValue).IsValid() {
+			return nil, key, false
+		}
+		indexValue.SetMapIndex(keyValue, actualValue.Index(i))
+	}
+	return indexValue.Interface(), nil, true
+}
+
+// IsComparableKey returns true if the given value can be used as a map key, that is it is nil or its type is
+// comparable, else false.
+func IsComparableKey(key interface{}) bool {
+	return key == nil || reflect.TypeOf(key).Comparable()
+}
+
+// WithoutNils returns a copy of the slice without its nil elements, that is nil pointers, interfaces, maps, slices,
+// channels and functions. The copy is a slice of the same element type.
+func (s SliceValue) WithoutNils() interface{} {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+	result := reflect.MakeSlice(actualValue.Type(), 0, actualValue.Len())
+
+	for i := 0; i < actualValue.Len(); i++ {
+		if !isNilValue(actualValue.Index(i)) {
+			result = reflect.Append(result, actualValue.Index(i))
+		}
+	}
+	return result.Interface()
+}
+
+func isNilValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+// IntElements returns the elements of a slice of signed integers as int64 values. It returns false if the value is
+// not a slice of signed integers.
+func (s SliceValue) IntElements() ([]int64, bool) {
+	if !IsSlice(s.Value()) {
+		return nil, false
+	}
+	actualValue := reflect.ValueOf(s.Value())
+	switch actualValue.Type().Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return nil, false
+	}
+
+	elements := make([]int64, actualValue.Len())
+	for i := range elements {
+		elements[i] = actualValue.Index(i).Int()
+	}
+	return elements, true
+}
+
+// ElementsDifference compares the elements of the slice with the given elements as multisets using reflect.DeepEqual,
+// so the order of the elements doesn't matter but their multiplicity does. It returns the given elements the slice
+// lacks and the elements of the slice that are not among the given ones.
+func (s SliceValue) ElementsDifference(elements []interface{}) (missing, extra []interface{}) {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+	matched := make([]bool, len(elements))
+
+	for i := 0; i < actualValue.Len(); i++ {
+		element, found := actualValue.Index(i).Interface(), false
+		for j := range elements {
+			if !matched[j] && reflect.DeepEqual(element, elements[j]) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			extra = append(extra, element)
+		}
+	}
+	for j, element := range elements {
+		if !matched[j] {
+			missing = append(missing, element)
+		}
+	}
+	return missing, extra
+}
+
+// NaturalLess returns a less function comparing the elements at the given indexes of the slice by their natural
+// order, for slices of integers, floating point numbers or strings. It returns false if the elements have no
+// natural order.
+func (s SliceValue) NaturalLess() (func(i, j int) bool, bool) {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+
+	switch actualValue.Type().Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(i, j int) bool { return actualValue.Index(i).Int() < actualValue.Index(j).Int() }, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(i, j int) bool { return actualValue.Index(i).Uint() < actualValue.Index(j).Uint() }, true
+	case reflect.Float32, reflect.Float64:
+		return func(i, j int) bool { return actualValue.Index(i).Float() < actualValue.Index(j).Float() }, true
+	case reflect.String:
+		return func(i, j int) bool { return actualValue.Index(i).String() < actualValue.Index(j).String() }, true
+	default:
+		return nil, false
+	}
+}
+
+// FirstUnsortedIndex returns the index of the first element of the slice that breaks its order according to the
+// given less function, that is the first element less than its previous one, or -1 if the slice is sorted.
+func (s SliceValue) FirstUnsortedIndex(less func(i, j int) bool) int {
+	for i := 1; i < s.Size(); i++ {
+		if less(i, i-1) {
+			return i
+		}
+	}
+	return -1
+}
+
+// RunLengthEncoding returns the runs of consecutive equal elements of the slice in order, so that the slice
+// [a a b a] is encoded as [{a 2} {b 1} {a 1}]. An empty slice has no runs.
+func (s SliceValue) RunLengthEncoding() []Run {
+	actualValue := asSlice(reflect.ValueOf(s.Value()))
+	runs := make([]Run, 0)
+
+	for i := 0; i < actualValue.Len(); i++ {
+		if i > 0 && areEqualValues(actualValue.Index(i), actualValue.Index(i-1)) {
+			runs[len(runs)-1].Count++
+			continue
+		}
+		runs = append(runs, Run{Value: actualValue.Index(i).Interface(), Count: 1})
+	}
+	return runs
+}
+
 // Value returns the actual value of the structure.
 func (s SliceValue) Value() interface{} {
 	return s.value
@@ -97,3 +390,13 @@ func NewSliceValue(value interface{}) SliceValue {
 func IsSlice(value interface{}) bool {
 	return reflect.ValueOf(value).Kind() == reflect.Slice || reflect.ValueOf(value).Kind() == reflect.Array
 }
+
+// asSlice returns the given value as a slice - arrays are copied to a slice of the same element type.
+func asSlice(value reflect.Value) reflect.Value {
+	if value.Kind() == reflect.Slice {
+		return value
+	}
+	slice := reflect.MakeSlice(reflect.SliceOf(value.Type().Elem()), value.Len(), value.Len())
+	reflect.Copy(slice, value)
+	return slice
+}
@@ -2,14 +2,18 @@ package values
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // StringValue value represents a string value.
 type StringValue struct {
-	value      string
-	decorators []StringDecorator
+	value         string
+	decorators    []StringDecorator
+	countingRunes bool
 }
 
 // StringDecorator is a function type to decorate a string.
@@ -20,6 +24,49 @@ func RemoveSpaces(value string) string {
 	return strings.ReplaceAll(value, " ", "")
 }
 
+// NormalizeLineEndings converts all \r\n and \r line endings of the given string to \n.
+func NormalizeLineEndings(value string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(value, "\r\n", "\n"), "\r", "\n")
+}
+
+// Dedent removes the common leading indentation of the non-blank lines of the given string. Lines containing only
+// white spaces are emptied.
+func Dedent(value string) string {
+	lines := strings.Split(value, "\n")
+	indent, found := "", false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lineIndent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !found {
+			indent, found = lineIndent, true
+			continue
+		}
+		for !strings.HasPrefix(lineIndent, indent) {
+			indent = indent[:len(indent)-1]
+		}
+	}
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+			continue
+		}
+		lines[i] = strings.TrimPrefix(line, indent)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RemovePunctuation removes all punctuation characters from the given string.
+func RemovePunctuation(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) {
+			return -1
+		}
+		return r
+	}, value)
+}
+
 // IsEqualTo returns true if the value is equal to the expected value, else false.
 func (s StringValue) IsEqualTo(expected interface{}) bool {
 	return s.DecoratedValue() == s.decoratedValue(expected)
@@ -42,12 +89,12 @@ func (s StringValue) IsGreaterOrEqualTo(expected interface{}) bool {
 
 // IsLessThan returns true if the value is less than the expected value, else false.
 func (s StringValue) IsLessThan(expected interface{}) bool {
-	return !s.IsGreaterOrEqualTo(s.decoratedValue(expected))
+	return !s.IsGreaterOrEqualTo(expected)
 }
 
 // IsLessOrEqualTo returns true if the value is less than or equal to the expected value, else false.
 func (s StringValue) IsLessOrEqualTo(expected interface{}) bool {
-	return !s.IsGreaterThan(s.decoratedValue(expected))
+	return !s.IsGreaterThan(expected)
 }
 
 // IsEmpty returns true if the string is empty else false.
@@ -55,6 +102,11 @@ func (s StringValue) IsEmpty() bool {
 	return s.DecoratedValue() == ""
 }
 
+// IsBlank returns true if the string is empty or contains only white spaces else false.
+func (s StringValue) IsBlank() bool {
+	return strings.TrimSpace(s.DecoratedValue()) == ""
+}
+
 // IsNotEmpty returns true if the string is not empty else false.
 func (s StringValue) IsNotEmpty() bool {
 	return !s.IsEmpty()
@@ -67,12 +119,12 @@ func (s StringValue) Contains(expected interface{}) bool {
 
 // ContainsIgnoringCase returns true if the string contains the given sub-string case insensitively.
 func (s StringValue) ContainsIgnoringCase(expected interface{}) bool {
-	return strings.Contains(strings.ToLower(s.DecoratedValue()), NewStringValue(strings.ToLower(s.decoratedValue(expected))).value)
+	return strings.Contains(strings.ToLower(s.DecoratedValue()), strings.ToLower(s.decoratedValue(expected)))
 }
 
 // DoesNotContain returns true if the string does not contain the given sub-string.
 func (s StringValue) DoesNotContain(expected interface{}) bool {
-	return !s.Contains(s.decoratedValue(expected))
+	return !s.Contains(expected)
 }
 
 // HasSize returns true if the string has the expected size else false.
@@ -80,9 +132,18 @@ func (s StringValue) HasSize(length int) bool {
 	return s.Size() == length
 }
 
-// Size returns the string size.
+// Size returns the size of the decorated string.
 func (s StringValue) Size() int {
-	return len(s.value)
+	return s.SizeOf(s.DecoratedValue())
+}
+
+// SizeOf returns the size of the given string the same way the size of the string value is measured: the number of
+// its runes if the string value counts runes, else the number of its bytes.
+func (s StringValue) SizeOf(value string) int {
+	if s.countingRunes {
+		return utf8.RuneCountInString(value)
+	}
+	return len(value)
 }
 
 // StartsWith returns true if the asserted value starts with the given string, else false.
@@ -108,7 +169,7 @@ func (s StringValue) DoesNotEndWith(substr string) bool {
 // ContainsOnly returns true if the string contains only the given sub-string
 // In other words if performs an equal operation.
 func (s StringValue) ContainsOnly(expected interface{}) bool {
-	return s.IsEqualTo(s.decoratedValue(expected))
+	return s.IsEqualTo(expected)
 }
 
 // ContainsOnlyOnce returns true if the string contains the given sub-string only once.
@@ -149,6 +210,97 @@ func (s StringValue) IsUpperCase() bool {
 	return s.IsEqualTo(strings.ToUpper(s.value))
 }
 
+// SortedRunes returns the runes of the decorated value and the decorated given string in ascending order.
+func (s StringValue) SortedRunes(other string) (actualRunes, otherRunes []rune) {
+	return sortedRunes(s.DecoratedValue()), sortedRunes(s.decoratedValue(other))
+}
+
+// IsAnagramOf returns true if the decorated value consists of exactly the same runes as the decorated given string,
+// regardless of their order, else false.
+func (s StringValue) IsAnagramOf(other string) bool {
+	actualRunes, otherRunes := s.SortedRunes(other)
+	return string(actualRunes) == string(otherRunes)
+}
+
+// IsEqualToIgnoringWhitespace returns true if the decorated value is equal to the decorated given string after
+// collapsing every run of white spaces of both to a single space and trimming their leading and trailing white spaces,
+// else false.
+func (s StringValue) IsEqualToIgnoringWhitespace(expected string) bool {
+	return CollapseWhitespace(s.DecoratedValue()) == CollapseWhitespace(s.decoratedValue(expected))
+}
+
+// EqualsIgnoringCase returns true if the decorated value is equal to the decorated given string under Unicode case
+// folding, else false.
+func (s StringValue) EqualsIgnoringCase(expected string) bool {
+	return strings.EqualFold(s.DecoratedValue(), s.decoratedValue(expected))
+}
+
+// CollapseWhitespace replaces every run of white spaces of the given string with a single space and removes its
+// leading and trailing white spaces.
+func CollapseWhitespace(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func sortedRunes(value string) []rune {
+	runes := []rune(value)
+	sort.Slice(runes, func(i, j int) bool {
+		return runes[i] < runes[j]
+	})
+	return runes
+}
+
+// EntropyBits returns the estimated entropy of the decorated value in bits: the Shannon entropy of its rune
+// frequencies multiplied by its length in runes. The estimate only considers how often each rune occurs in the value,
+// not how predictable their order is, so it's an upper bound for strings with patterns such as "abcabc".
+func (s StringValue) EntropyBits() float64 {
+	runes := []rune(s.DecoratedValue())
+	frequencies := map[rune]int{}
+	for _, r := range runes {
+		frequencies[r]++
+	}
+
+	entropy := 0.0
+	for _, frequency := range frequencies {
+		probability := float64(frequency) / float64(len(runes))
+		entropy -= probability * math.Log2(probability)
+	}
+	return entropy * float64(len(runes))
+}
+
+// EditDistance returns the Levenshtein distance between the decorated value and the given string, that is the minimum
+// number of single rune insertions, deletions or substitutions needed to change one into the other.
+func (s StringValue) EditDistance(other string) int {
+	source, target := []rune(s.DecoratedValue()), []rune(s.decoratedValue(other))
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			substitution := previous[j-1]
+			if source[i-1] != target[j-1] {
+				substitution++
+			}
+			current[j] = minOf(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+func minOf(first int, others ...int) int {
+	m := first
+	for _, v := range others {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
 // NewStringValue creates and returns a StringValue struct initialed with the given value.
 func NewStringValue(value interface{}) StringValue {
 	switch v := value.(type) {
@@ -159,6 +311,12 @@ func NewStringValue(value interface{}) StringValue {
 	}
 }
 
+// CountingRunes sets the size of the string value to be measured in runes instead of bytes.
+func (s StringValue) CountingRunes() StringValue {
+	s.countingRunes = true
+	return s
+}
+
 // AddDecorator adds a new string decorator to the assertable string value.
 func (s StringValue) AddDecorator(decorator StringDecorator) StringValue {
 	s.decorators = append(s.decorators, decorator)
@@ -173,6 +331,12 @@ func (s StringValue) decoratedValue(value interface{}) string {
 	return decoratedValue
 }
 
+// Decorate returns the given string after applying all the defined decorators, so it can be compared with the
+// decorated value.
+func (s StringValue) Decorate(value string) string {
+	return s.decoratedValue(value)
+}
+
 // DecoratedValue returns the asserted string value after applying all the defined decorators.
 func (s StringValue) DecoratedValue() string {
 	return s.decoratedValue(s.value)
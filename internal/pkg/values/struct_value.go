@@ -1,6 +1,9 @@
 package values
 
-import "reflect"
+import (
+	"reflect"
+	"strings"
+)
 
 // StructValue is a struct that holds a struct value.
 type StructValue struct {
@@ -46,6 +49,33 @@ func (s StructValue) IsEqualTo(expected interface{}) bool {
 	return true
 }
 
+// Field returns the value of the exported field with the given name and true, or nil and false if the struct value has
+// no such field. Fields of nested structs are named by their path, for example "Address.City", and fields promoted
+// from embedded structs can be named either directly or through the embedded struct. Unexported fields are skipped, so
+// they are never found.
+func (s StructValue) Field(name string) (interface{}, bool) {
+	field, ok := fieldByPath(reflect.ValueOf(s.value), name, true)
+	if !ok {
+		return nil, false
+	}
+	return field.Interface(), true
+}
+
+// FirstDifferentField compares the exported fields of the struct value and the expected value after setting the given
+// fields of copies of both to their zero value, descending into nested structs with exported fields. Nested structs
+// without exported fields, such as time.Time, are compared as a whole. It returns the path of the first
+// different field and true, or an empty path and false if both are equal. If the values are not structs of the same
+// type, it returns an empty path and true. Unexported fields are skipped and fields of nested structs held through
+// pointers are not ignored.
+func (s StructValue) FirstDifferentField(expected interface{}, ignoredFields ...string) (string, bool) {
+	actualElement, expectedElement := indirect(reflect.ValueOf(s.value)), indirect(reflect.ValueOf(expected))
+	if actualElement.Kind() != reflect.Struct || !expectedElement.IsValid() || actualElement.Type() != expectedElement.Type() {
+		return "", true
+	}
+	actualCopy, expectedCopy := zeroedCopy(actualElement, ignoredFields), zeroedCopy(expectedElement, ignoredFields)
+	return firstDifferentField(actualCopy, expectedCopy, "")
+}
+
 // Value returns the actual value of the structure.
 func (s StructValue) Value() interface{} {
 	return s.value
@@ -64,3 +94,76 @@ func sliceContains(slice []string, s string) bool {
 	}
 	return false
 }
+
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
+
+func fieldByPath(value reflect.Value, path string, followPointers bool) (reflect.Value, bool) {
+	for _, name := range strings.Split(path, ".") {
+		if followPointers {
+			value = indirect(value)
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		field, ok := value.Type().FieldByName(name)
+		if !ok || field.PkgPath != "" {
+			return reflect.Value{}, false
+		}
+		for i, index := range field.Index {
+			if i > 0 && value.Kind() == reflect.Ptr {
+				if !followPointers || value.IsNil() {
+					return reflect.Value{}, false
+				}
+				value = value.Elem()
+			}
+			value = value.Field(index)
+		}
+	}
+	return value, value.CanInterface()
+}
+
+func zeroedCopy(value reflect.Value, fields []string) reflect.Value {
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+	// Pointers are not followed, so that the values they point to, which are shared with the original, are left intact.
+	for _, name := range fields {
+		if field, ok := fieldByPath(copied, name, false); ok && field.CanSet() {
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+	return copied
+}
+
+func firstDifferentField(actual, expected reflect.Value, prefix string) (string, bool) {
+	for i := 0; i < actual.NumField(); i++ {
+		field := actual.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		actualField, expectedField := actual.Field(i), expected.Field(i)
+		if actualField.Kind() == reflect.Struct && hasExportedFields(actualField.Type()) {
+			if path, different := firstDifferentField(actualField, expectedField, prefix+field.Name+"."); different {
+				return path, true
+			}
+			continue
+		}
+		if !reflect.DeepEqual(actualField.Interface(), expectedField.Interface()) {
+			return prefix + field.Name, true
+		}
+	}
+	return "", false
+}
+
+func hasExportedFields(structType reflect.Type) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		if structType.Field(i).PkgPath == "" {
+			return true
+		}
+	}
+	return false
+}
@@ -74,3 +74,12 @@ func NewMapEntry(key, value interface{}) MapEntry {
 		value: value,
 	}
 }
+
+// Ordered is the constraint of the types supporting the < <= >= > operators: the integer, floating point and string
+// types, and the types derived from them.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}